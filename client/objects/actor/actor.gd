@@ -6,6 +6,7 @@ const Scene := preload("res://objects/actor/actor.tscn")
 const Actor := preload("res://objects/actor/actor.gd")
 
 var actor_id: int
+var cell_id: int
 var actor_name: String
 var start_x: float
 var start_y: float
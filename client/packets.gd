@@ -661,6 +661,18 @@ class PBPacker:
 ############### USER DATA BEGIN ################
 
 
+enum WorldShape {
+	WORLD_RECT = 0,
+	WORLD_CIRCLE = 1,
+	WORLD_TORUS = 2
+}
+
+enum ZoneState {
+	ZONE_NEUTRAL = 0,
+	ZONE_CONTESTED = 1,
+	ZONE_HELD = 2
+}
+
 class ChatMessage:
 	func _init():
 		var service
@@ -670,6 +682,16 @@ class ChatMessage:
 		service.field = _msg
 		data[_msg.tag] = service
 		
+		_team_only = PBField.new("team_only", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = _team_only
+		data[_team_only.tag] = service
+		
+		_team = PBField.new("team", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = _team
+		data[_team.tag] = service
+		
 	var data = {}
 	
 	var _msg: PBField
@@ -681,6 +703,24 @@ class ChatMessage:
 	func set_msg(value : String) -> void:
 		_msg.value = value
 	
+	var _team_only: PBField
+	func get_team_only() -> bool:
+		return _team_only.value
+	func clear_team_only() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_team_only.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_team_only(value : bool) -> void:
+		_team_only.value = value
+	
+	var _team: PBField
+	func get_team() -> int:
+		return _team.value
+	func clear_team() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_team.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_team(value : int) -> void:
+		_team.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -757,6 +797,16 @@ class LoginRequestMessage:
 		service.field = _password
 		data[_password.tag] = service
 		
+		_arena_id = PBField.new("arena_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _arena_id
+		data[_arena_id.tag] = service
+		
+		_invite_code = PBField.new("invite_code", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _invite_code
+		data[_invite_code.tag] = service
+		
 	var data = {}
 	
 	var _username: PBField
@@ -777,6 +827,24 @@ class LoginRequestMessage:
 	func set_password(value : String) -> void:
 		_password.value = value
 	
+	var _arena_id: PBField
+	func get_arena_id() -> int:
+		return _arena_id.value
+	func clear_arena_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_arena_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_arena_id(value : int) -> void:
+		_arena_id.value = value
+	
+	var _invite_code: PBField
+	func get_invite_code() -> String:
+		return _invite_code.value
+	func clear_invite_code() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_invite_code.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_invite_code(value : String) -> void:
+		_invite_code.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -979,6 +1047,51 @@ class PlayerMessage:
 		service.field = _color
 		data[_color.tag] = service
 		
+		_cell_id = PBField.new("cell_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _cell_id
+		data[_cell_id.tag] = service
+		
+		_effects = PBField.new("effects", PB_DATA_TYPE.STRING, PB_RULE.REPEATED, 10, true, [])
+		service = PBServiceField.new()
+		service.field = _effects
+		data[_effects.tag] = service
+		
+		_protected = PBField.new("protected", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 11, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = _protected
+		data[_protected.tag] = service
+		
+		_team = PBField.new("team", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 12, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = _team
+		data[_team.tag] = service
+		
+		_role = PBField.new("role", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 13, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _role
+		data[_role.tag] = service
+		
+		_zone_points = PBField.new("zone_points", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 14, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _zone_points
+		data[_zone_points.tag] = service
+		
+		_bounty = PBField.new("bounty", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 15, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _bounty
+		data[_bounty.tag] = service
+		
+		_vel_x = PBField.new("vel_x", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 16, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _vel_x
+		data[_vel_x.tag] = service
+		
+		_vel_y = PBField.new("vel_y", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 17, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _vel_y
+		data[_vel_y.tag] = service
+		
 	var data = {}
 	
 	var _id: PBField
@@ -1053,6 +1166,87 @@ class PlayerMessage:
 	func set_color(value : int) -> void:
 		_color.value = value
 	
+	var _cell_id: PBField
+	func get_cell_id() -> int:
+		return _cell_id.value
+	func clear_cell_id() -> void:
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_cell_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_cell_id(value : int) -> void:
+		_cell_id.value = value
+	
+	var _effects: PBField
+	func get_effects() -> Array:
+		return _effects.value
+	func clear_effects() -> void:
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_effects.value = []
+	func add_effects(value : String) -> void:
+		_effects.value.append(value)
+	
+	var _protected: PBField
+	func get_protected() -> bool:
+		return _protected.value
+	func clear_protected() -> void:
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_protected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_protected(value : bool) -> void:
+		_protected.value = value
+	
+	var _team: PBField
+	func get_team() -> int:
+		return _team.value
+	func clear_team() -> void:
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_team.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_team(value : int) -> void:
+		_team.value = value
+	
+	var _role: PBField
+	func get_role() -> String:
+		return _role.value
+	func clear_role() -> void:
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_role.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_role(value : String) -> void:
+		_role.value = value
+	
+	var _zone_points: PBField
+	func get_zone_points() -> int:
+		return _zone_points.value
+	func clear_zone_points() -> void:
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_zone_points.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_zone_points(value : int) -> void:
+		_zone_points.value = value
+	
+	var _bounty: PBField
+	func get_bounty() -> int:
+		return _bounty.value
+	func clear_bounty() -> void:
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_bounty.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_bounty(value : int) -> void:
+		_bounty.value = value
+	
+	var _vel_x: PBField
+	func get_vel_x() -> float:
+		return _vel_x.value
+	func clear_vel_x() -> void:
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_vel_x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_vel_x(value : float) -> void:
+		_vel_x.value = value
+	
+	var _vel_y: PBField
+	func get_vel_y() -> float:
+		return _vel_y.value
+	func clear_vel_y() -> void:
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_vel_y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_vel_y(value : float) -> void:
+		_vel_y.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1083,6 +1277,11 @@ class PlayerDirectionMessage:
 		service.field = _direction
 		data[_direction.tag] = service
 		
+		_intensity = PBField.new("intensity", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _intensity
+		data[_intensity.tag] = service
+		
 	var data = {}
 	
 	var _direction: PBField
@@ -1094,6 +1293,18 @@ class PlayerDirectionMessage:
 	func set_direction(value : float) -> void:
 		_direction.value = value
 	
+	var _intensity: PBField
+	func has_intensity() -> bool:
+		return data[2].state == PB_SERVICE_STATE.FILLED
+	func get_intensity() -> float:
+		return _intensity.value
+	func clear_intensity() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_intensity.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_intensity(value : float) -> void:
+		data[2].state = PB_SERVICE_STATE.FILLED
+		_intensity.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1139,6 +1350,11 @@ class SporeMessage:
 		service.field = _radius
 		data[_radius.tag] = service
 		
+		_kind = PBField.new("kind", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _kind
+		data[_kind.tag] = service
+		
 	var data = {}
 	
 	var _id: PBField
@@ -1177,6 +1393,15 @@ class SporeMessage:
 	func set_radius(value : float) -> void:
 		_radius.value = value
 	
+	var _kind: PBField
+	func get_kind() -> String:
+		return _kind.value
+	func clear_kind() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_kind.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_kind(value : String) -> void:
+		_kind.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1207,6 +1432,11 @@ class SporeConsumedMessage:
 		service.field = _spore_id
 		data[_spore_id.tag] = service
 		
+		_cell_id = PBField.new("cell_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _cell_id
+		data[_cell_id.tag] = service
+		
 	var data = {}
 	
 	var _spore_id: PBField
@@ -1218,6 +1448,15 @@ class SporeConsumedMessage:
 	func set_spore_id(value : int) -> void:
 		_spore_id.value = value
 	
+	var _cell_id: PBField
+	func get_cell_id() -> int:
+		return _cell_id.value
+	func clear_cell_id() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_cell_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_cell_id(value : int) -> void:
+		_cell_id.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1292,6 +1531,16 @@ class PlayerConsumedMessage:
 		service.field = _player_id
 		data[_player_id.tag] = service
 		
+		_cell_id = PBField.new("cell_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _cell_id
+		data[_cell_id.tag] = service
+		
+		_eater_cell_id = PBField.new("eater_cell_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _eater_cell_id
+		data[_eater_cell_id.tag] = service
+		
 	var data = {}
 	
 	var _player_id: PBField
@@ -1303,6 +1552,24 @@ class PlayerConsumedMessage:
 	func set_player_id(value : int) -> void:
 		_player_id.value = value
 	
+	var _cell_id: PBField
+	func get_cell_id() -> int:
+		return _cell_id.value
+	func clear_cell_id() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_cell_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_cell_id(value : int) -> void:
+		_cell_id.value = value
+	
+	var _eater_cell_id: PBField
+	func get_eater_cell_id() -> int:
+		return _eater_cell_id.value
+	func clear_eater_cell_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_eater_cell_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_eater_cell_id(value : int) -> void:
+		_eater_cell_id.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1328,8 +1595,22 @@ class HiscoreBoardRequestMessage:
 	func _init():
 		var service
 		
+		_category = PBField.new("category", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _category
+		data[_category.tag] = service
+		
 	var data = {}
 	
+	var _category: PBField
+	func get_category() -> String:
+		return _category.value
+	func clear_category() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_category.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_category(value : String) -> void:
+		_category.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1430,6 +1711,11 @@ class HiscoreBoardMessage:
 		service.func_ref = Callable(self, "add_hiscores")
 		data[_hiscores.tag] = service
 		
+		_category = PBField.new("category", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _category
+		data[_category.tag] = service
+		
 	var data = {}
 	
 	var _hiscores: PBField
@@ -1443,6 +1729,15 @@ class HiscoreBoardMessage:
 		_hiscores.value.append(element)
 		return element
 	
+	var _category: PBField
+	func get_category() -> String:
+		return _category.value
+	func clear_category() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_category.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_category(value : String) -> void:
+		_category.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1573,144 +1868,5848 @@ class DisconnectMessage:
 			return PB_ERR.PARSE_INCOMPLETE
 		return result
 	
-class Packet:
+class ArenaListRequestMessage:
 	func _init():
 		var service
 		
-		_sender_id = PBField.new("sender_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
-		service = PBServiceField.new()
-		service.field = _sender_id
-		data[_sender_id.tag] = service
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
 		
-		_chat = PBField.new("chat", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = _chat
-		service.func_ref = Callable(self, "new_chat")
-		data[_chat.tag] = service
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
 		
-		_id = PBField.new("id", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ArenaMessage:
+	func _init():
+		var service
+		
+		_id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
 		service.field = _id
-		service.func_ref = Callable(self, "new_id")
 		data[_id.tag] = service
 		
-		_login_request = PBField.new("login_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_players = PBField.new("players", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = _login_request
-		service.func_ref = Callable(self, "new_login_request")
-		data[_login_request.tag] = service
+		service.field = _players
+		data[_players.tag] = service
 		
-		_register_request = PBField.new("register_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_max_players = PBField.new("max_players", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = _register_request
-		service.func_ref = Callable(self, "new_register_request")
-		data[_register_request.tag] = service
+		service.field = _max_players
+		data[_max_players.tag] = service
 		
-		_ok_response = PBField.new("ok_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_spectators = PBField.new("spectators", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = _ok_response
-		service.func_ref = Callable(self, "new_ok_response")
-		data[_ok_response.tag] = service
+		service.field = _spectators
+		data[_spectators.tag] = service
 		
-		_deny_response = PBField.new("deny_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_map_name = PBField.new("map_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = _deny_response
-		service.func_ref = Callable(self, "new_deny_response")
-		data[_deny_response.tag] = service
+		service.field = _map_name
+		data[_map_name.tag] = service
 		
-		_player = PBField.new("player", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 8, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_mode = PBField.new("mode", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = _player
-		service.func_ref = Callable(self, "new_player")
-		data[_player.tag] = service
+		service.field = _mode
+		data[_mode.tag] = service
 		
-		_player_direction = PBField.new("player_direction", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = _player_direction
-		service.func_ref = Callable(self, "new_player_direction")
-		data[_player_direction.tag] = service
+	var data = {}
+	
+	var _id: PBField
+	func get_id() -> int:
+		return _id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		_id.value = value
+	
+	var _players: PBField
+	func get_players() -> int:
+		return _players.value
+	func clear_players() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_players(value : int) -> void:
+		_players.value = value
+	
+	var _max_players: PBField
+	func get_max_players() -> int:
+		return _max_players.value
+	func clear_max_players() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_max_players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_max_players(value : int) -> void:
+		_max_players.value = value
+	
+	var _spectators: PBField
+	func get_spectators() -> int:
+		return _spectators.value
+	func clear_spectators() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_spectators.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_spectators(value : int) -> void:
+		_spectators.value = value
+	
+	var _map_name: PBField
+	func get_map_name() -> String:
+		return _map_name.value
+	func clear_map_name() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_map_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_map_name(value : String) -> void:
+		_map_name.value = value
+	
+	var _mode: PBField
+	func get_mode() -> String:
+		return _mode.value
+	func clear_mode() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_mode(value : String) -> void:
+		_mode.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
 		
-		_spore = PBField.new("spore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 10, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = _spore
-		service.func_ref = Callable(self, "new_spore")
-		data[_spore.tag] = service
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
 		
-		_spore_consumed = PBField.new("spore_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 11, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ArenaListMessage:
+	func _init():
+		var service
+		
+		_arenas = PBField.new("arenas", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, [])
 		service = PBServiceField.new()
-		service.field = _spore_consumed
-		service.func_ref = Callable(self, "new_spore_consumed")
-		data[_spore_consumed.tag] = service
+		service.field = _arenas
+		service.func_ref = Callable(self, "add_arenas")
+		data[_arenas.tag] = service
 		
-		_spores_batch = PBField.new("spores_batch", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 12, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+	var data = {}
+	
+	var _arenas: PBField
+	func get_arenas() -> Array:
+		return _arenas.value
+	func clear_arenas() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_arenas.value = []
+	func add_arenas() -> ArenaMessage:
+		var element = ArenaMessage.new()
+		_arenas.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class CreateArenaRequestMessage:
+	func _init():
+		var service
+		
+		_world_size = PBField.new("world_size", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
 		service = PBServiceField.new()
-		service.field = _spores_batch
-		service.func_ref = Callable(self, "new_spores_batch")
-		data[_spores_batch.tag] = service
+		service.field = _world_size
+		data[_world_size.tag] = service
 		
-		_player_consumed = PBField.new("player_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 13, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_max_spores = PBField.new("max_spores", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = _player_consumed
-		service.func_ref = Callable(self, "new_player_consumed")
-		data[_player_consumed.tag] = service
+		service.field = _max_spores
+		data[_max_spores.tag] = service
 		
-		_hiscore_board_request = PBField.new("hiscore_board_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 14, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_max_players = PBField.new("max_players", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = _hiscore_board_request
-		service.func_ref = Callable(self, "new_hiscore_board_request")
-		data[_hiscore_board_request.tag] = service
+		service.field = _max_players
+		data[_max_players.tag] = service
 		
-		_hiscore = PBField.new("hiscore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 15, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_record_hiscores = PBField.new("record_hiscores", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
 		service = PBServiceField.new()
-		service.field = _hiscore
-		service.func_ref = Callable(self, "new_hiscore")
-		data[_hiscore.tag] = service
+		service.field = _record_hiscores
+		data[_record_hiscores.tag] = service
 		
-		_hiscore_board = PBField.new("hiscore_board", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 16, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_world_shape = PBField.new("world_shape", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
 		service = PBServiceField.new()
-		service.field = _hiscore_board
-		service.func_ref = Callable(self, "new_hiscore_board")
-		data[_hiscore_board.tag] = service
+		service.field = _world_shape
+		data[_world_shape.tag] = service
 		
-		_finished_browsing_hiscores = PBField.new("finished_browsing_hiscores", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 17, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_map_name = PBField.new("map_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = _finished_browsing_hiscores
-		service.func_ref = Callable(self, "new_finished_browsing_hiscores")
-		data[_finished_browsing_hiscores.tag] = service
+		service.field = _map_name
+		data[_map_name.tag] = service
 		
-		_search_hiscore = PBField.new("search_hiscore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 18, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		_mode = PBField.new("mode", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = _search_hiscore
-		service.func_ref = Callable(self, "new_search_hiscore")
-		data[_search_hiscore.tag] = service
+		service.field = _mode
+		data[_mode.tag] = service
 		
-		_disconnect = PBField.new("disconnect", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 19, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+	var data = {}
+	
+	var _world_size: PBField
+	func get_world_size() -> float:
+		return _world_size.value
+	func clear_world_size() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_world_size.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_world_size(value : float) -> void:
+		_world_size.value = value
+	
+	var _max_spores: PBField
+	func get_max_spores() -> int:
+		return _max_spores.value
+	func clear_max_spores() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_max_spores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_max_spores(value : int) -> void:
+		_max_spores.value = value
+	
+	var _max_players: PBField
+	func get_max_players() -> int:
+		return _max_players.value
+	func clear_max_players() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_max_players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_max_players(value : int) -> void:
+		_max_players.value = value
+	
+	var _record_hiscores: PBField
+	func get_record_hiscores() -> bool:
+		return _record_hiscores.value
+	func clear_record_hiscores() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_record_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_record_hiscores(value : bool) -> void:
+		_record_hiscores.value = value
+	
+	var _world_shape: PBField
+	func get_world_shape():
+		return _world_shape.value
+	func clear_world_shape() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_world_shape.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_world_shape(value) -> void:
+		_world_shape.value = value
+	
+	var _map_name: PBField
+	func get_map_name() -> String:
+		return _map_name.value
+	func clear_map_name() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_map_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_map_name(value : String) -> void:
+		_map_name.value = value
+	
+	var _mode: PBField
+	func get_mode() -> String:
+		return _mode.value
+	func clear_mode() -> void:
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_mode(value : String) -> void:
+		_mode.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ArenaCreatedMessage:
+	func _init():
+		var service
+		
+		_arena_id = PBField.new("arena_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = _disconnect
-		service.func_ref = Callable(self, "new_disconnect")
-		data[_disconnect.tag] = service
+		service.field = _arena_id
+		data[_arena_id.tag] = service
+		
+		_invite_code = PBField.new("invite_code", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _invite_code
+		data[_invite_code.tag] = service
 		
 	var data = {}
 	
-	var _sender_id: PBField
-	func get_sender_id() -> int:
-		return _sender_id.value
-	func clear_sender_id() -> void:
+	var _arena_id: PBField
+	func get_arena_id() -> int:
+		return _arena_id.value
+	func clear_arena_id() -> void:
 		data[1].state = PB_SERVICE_STATE.UNFILLED
-		_sender_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
-	func set_sender_id(value : int) -> void:
-		_sender_id.value = value
+		_arena_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_arena_id(value : int) -> void:
+		_arena_id.value = value
+	
+	var _invite_code: PBField
+	func get_invite_code() -> String:
+		return _invite_code.value
+	func clear_invite_code() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_invite_code.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_invite_code(value : String) -> void:
+		_invite_code.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class KickPlayerMessage:
+	func _init():
+		var service
+		
+		_player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _player_id
+		data[_player_id.tag] = service
+		
+	var data = {}
+	
+	var _player_id: PBField
+	func get_player_id() -> int:
+		return _player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		_player_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SpectateRequestMessage:
+	func _init():
+		var service
+		
+		_arena_id = PBField.new("arena_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _arena_id
+		data[_arena_id.tag] = service
+		
+		_invite_code = PBField.new("invite_code", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _invite_code
+		data[_invite_code.tag] = service
+		
+		_player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _player_id
+		data[_player_id.tag] = service
+		
+	var data = {}
+	
+	var _arena_id: PBField
+	func get_arena_id() -> int:
+		return _arena_id.value
+	func clear_arena_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_arena_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_arena_id(value : int) -> void:
+		_arena_id.value = value
+	
+	var _invite_code: PBField
+	func get_invite_code() -> String:
+		return _invite_code.value
+	func clear_invite_code() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_invite_code.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_invite_code(value : String) -> void:
+		_invite_code.value = value
+	
+	var _player_id: PBField
+	func get_player_id() -> int:
+		return _player_id.value
+	func clear_player_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		_player_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SpectatingMessage:
+	func _init():
+		var service
+		
+		_arena_id = PBField.new("arena_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _arena_id
+		data[_arena_id.tag] = service
+		
+		_player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _player_id
+		data[_player_id.tag] = service
+		
+	var data = {}
+	
+	var _arena_id: PBField
+	func get_arena_id() -> int:
+		return _arena_id.value
+	func clear_arena_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_arena_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_arena_id(value : int) -> void:
+		_arena_id.value = value
+	
+	var _player_id: PBField
+	func get_player_id() -> int:
+		return _player_id.value
+	func clear_player_id() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		_player_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class FinishedSpectatingMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class DeathSummaryMessage:
+	func _init():
+		var service
+		
+		_killer_id = PBField.new("killer_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _killer_id
+		data[_killer_id.tag] = service
+		
+		_killer_name = PBField.new("killer_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _killer_name
+		data[_killer_name.tag] = service
+		
+		_peak_mass = PBField.new("peak_mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _peak_mass
+		data[_peak_mass.tag] = service
+		
+		_time_alive_ms = PBField.new("time_alive_ms", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _time_alive_ms
+		data[_time_alive_ms.tag] = service
+		
+		_spores_eaten = PBField.new("spores_eaten", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _spores_eaten
+		data[_spores_eaten.tag] = service
+		
+	var data = {}
+	
+	var _killer_id: PBField
+	func get_killer_id() -> int:
+		return _killer_id.value
+	func clear_killer_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_killer_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_killer_id(value : int) -> void:
+		_killer_id.value = value
+	
+	var _killer_name: PBField
+	func get_killer_name() -> String:
+		return _killer_name.value
+	func clear_killer_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_killer_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_killer_name(value : String) -> void:
+		_killer_name.value = value
+	
+	var _peak_mass: PBField
+	func get_peak_mass() -> int:
+		return _peak_mass.value
+	func clear_peak_mass() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_peak_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_peak_mass(value : int) -> void:
+		_peak_mass.value = value
+	
+	var _time_alive_ms: PBField
+	func get_time_alive_ms() -> int:
+		return _time_alive_ms.value
+	func clear_time_alive_ms() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_time_alive_ms.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_time_alive_ms(value : int) -> void:
+		_time_alive_ms.value = value
+	
+	var _spores_eaten: PBField
+	func get_spores_eaten() -> int:
+		return _spores_eaten.value
+	func clear_spores_eaten() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_spores_eaten.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_spores_eaten(value : int) -> void:
+		_spores_eaten.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RespawnRequestMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SplitMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class CellRemovedMessage:
+	func _init():
+		var service
+		
+		_player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _player_id
+		data[_player_id.tag] = service
+		
+		_cell_id = PBField.new("cell_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _cell_id
+		data[_cell_id.tag] = service
+		
+	var data = {}
+	
+	var _player_id: PBField
+	func get_player_id() -> int:
+		return _player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		_player_id.value = value
+	
+	var _cell_id: PBField
+	func get_cell_id() -> int:
+		return _cell_id.value
+	func clear_cell_id() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_cell_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_cell_id(value : int) -> void:
+		_cell_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class EjectMassMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class HazardMessage:
+	func _init():
+		var service
+		
+		_id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _id
+		data[_id.tag] = service
+		
+		_x = PBField.new("x", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _x
+		data[_x.tag] = service
+		
+		_y = PBField.new("y", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _y
+		data[_y.tag] = service
+		
+		_radius = PBField.new("radius", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _radius
+		data[_radius.tag] = service
+		
+	var data = {}
+	
+	var _id: PBField
+	func get_id() -> int:
+		return _id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		_id.value = value
+	
+	var _x: PBField
+	func get_x() -> float:
+		return _x.value
+	func clear_x() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_x(value : float) -> void:
+		_x.value = value
+	
+	var _y: PBField
+	func get_y() -> float:
+		return _y.value
+	func clear_y() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_y(value : float) -> void:
+		_y.value = value
+	
+	var _radius: PBField
+	func get_radius() -> float:
+		return _radius.value
+	func clear_radius() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_radius.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_radius(value : float) -> void:
+		_radius.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class HazardConsumedMessage:
+	func _init():
+		var service
+		
+		_hazard_id = PBField.new("hazard_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _hazard_id
+		data[_hazard_id.tag] = service
+		
+		_cell_id = PBField.new("cell_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _cell_id
+		data[_cell_id.tag] = service
+		
+	var data = {}
+	
+	var _hazard_id: PBField
+	func get_hazard_id() -> int:
+		return _hazard_id.value
+	func clear_hazard_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_hazard_id(value : int) -> void:
+		_hazard_id.value = value
+	
+	var _cell_id: PBField
+	func get_cell_id() -> int:
+		return _cell_id.value
+	func clear_cell_id() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_cell_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_cell_id(value : int) -> void:
+		_cell_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class BoostMessage:
+	func _init():
+		var service
+		
+		_active = PBField.new("active", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = _active
+		data[_active.tag] = service
+		
+	var data = {}
+	
+	var _active: PBField
+	func get_active() -> bool:
+		return _active.value
+	func clear_active() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_active.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_active(value : bool) -> void:
+		_active.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PowerUpMessage:
+	func _init():
+		var service
+		
+		_id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _id
+		data[_id.tag] = service
+		
+		_x = PBField.new("x", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _x
+		data[_x.tag] = service
+		
+		_y = PBField.new("y", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _y
+		data[_y.tag] = service
+		
+		_radius = PBField.new("radius", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _radius
+		data[_radius.tag] = service
+		
+		_kind = PBField.new("kind", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _kind
+		data[_kind.tag] = service
+		
+	var data = {}
+	
+	var _id: PBField
+	func get_id() -> int:
+		return _id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		_id.value = value
+	
+	var _x: PBField
+	func get_x() -> float:
+		return _x.value
+	func clear_x() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_x(value : float) -> void:
+		_x.value = value
+	
+	var _y: PBField
+	func get_y() -> float:
+		return _y.value
+	func clear_y() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_y(value : float) -> void:
+		_y.value = value
+	
+	var _radius: PBField
+	func get_radius() -> float:
+		return _radius.value
+	func clear_radius() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_radius.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_radius(value : float) -> void:
+		_radius.value = value
+	
+	var _kind: PBField
+	func get_kind() -> String:
+		return _kind.value
+	func clear_kind() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_kind.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_kind(value : String) -> void:
+		_kind.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PowerUpCollectedMessage:
+	func _init():
+		var service
+		
+		_power_up_id = PBField.new("power_up_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _power_up_id
+		data[_power_up_id.tag] = service
+		
+		_kind = PBField.new("kind", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _kind
+		data[_kind.tag] = service
+		
+		_duration_ms = PBField.new("duration_ms", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _duration_ms
+		data[_duration_ms.tag] = service
+		
+	var data = {}
+	
+	var _power_up_id: PBField
+	func get_power_up_id() -> int:
+		return _power_up_id.value
+	func clear_power_up_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_power_up_id(value : int) -> void:
+		_power_up_id.value = value
+	
+	var _kind: PBField
+	func get_kind() -> String:
+		return _kind.value
+	func clear_kind() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_kind.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_kind(value : String) -> void:
+		_kind.value = value
+	
+	var _duration_ms: PBField
+	func get_duration_ms() -> int:
+		return _duration_ms.value
+	func clear_duration_ms() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_duration_ms.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_duration_ms(value : int) -> void:
+		_duration_ms.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class GameModeMessage:
+	func _init():
+		var service
+		
+		_name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _name
+		data[_name.tag] = service
+		
+		_round_ms_left = PBField.new("round_ms_left", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _round_ms_left
+		data[_round_ms_left.tag] = service
+		
+		_lobby = PBField.new("lobby", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = _lobby
+		data[_lobby.tag] = service
+		
+	var data = {}
+	
+	var _name: PBField
+	func get_name() -> String:
+		return _name.value
+	func clear_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		_name.value = value
+	
+	var _round_ms_left: PBField
+	func get_round_ms_left() -> int:
+		return _round_ms_left.value
+	func clear_round_ms_left() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_round_ms_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_round_ms_left(value : int) -> void:
+		_round_ms_left.value = value
+	
+	var _lobby: PBField
+	func get_lobby() -> bool:
+		return _lobby.value
+	func clear_lobby() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_lobby.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_lobby(value : bool) -> void:
+		_lobby.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SafeZoneMessage:
+	func _init():
+		var service
+		
+		_x = PBField.new("x", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _x
+		data[_x.tag] = service
+		
+		_y = PBField.new("y", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _y
+		data[_y.tag] = service
+		
+		_radius = PBField.new("radius", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _radius
+		data[_radius.tag] = service
+		
+	var data = {}
+	
+	var _x: PBField
+	func get_x() -> float:
+		return _x.value
+	func clear_x() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_x(value : float) -> void:
+		_x.value = value
+	
+	var _y: PBField
+	func get_y() -> float:
+		return _y.value
+	func clear_y() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_y(value : float) -> void:
+		_y.value = value
+	
+	var _radius: PBField
+	func get_radius() -> float:
+		return _radius.value
+	func clear_radius() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_radius.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_radius(value : float) -> void:
+		_radius.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class StandingMessage:
+	func _init():
+		var service
+		
+		_name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _name
+		data[_name.tag] = service
+		
+		_score = PBField.new("score", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _score
+		data[_score.tag] = service
+		
+	var data = {}
+	
+	var _name: PBField
+	func get_name() -> String:
+		return _name.value
+	func clear_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		_name.value = value
+	
+	var _score: PBField
+	func get_score() -> int:
+		return _score.value
+	func clear_score() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_score.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_score(value : int) -> void:
+		_score.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RoundOverMessage:
+	func _init():
+		var service
+		
+		_winner = PBField.new("winner", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _winner
+		data[_winner.tag] = service
+		
+		_standings = PBField.new("standings", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 2, true, [])
+		service = PBServiceField.new()
+		service.field = _standings
+		service.func_ref = Callable(self, "add_standings")
+		data[_standings.tag] = service
+		
+	var data = {}
+	
+	var _winner: PBField
+	func get_winner() -> String:
+		return _winner.value
+	func clear_winner() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_winner.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_winner(value : String) -> void:
+		_winner.value = value
+	
+	var _standings: PBField
+	func get_standings() -> Array:
+		return _standings.value
+	func clear_standings() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_standings.value = []
+	func add_standings() -> StandingMessage:
+		var element = StandingMessage.new()
+		_standings.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class MassShareMessage:
+	func _init():
+		var service
+		
+		_player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _player_id
+		data[_player_id.tag] = service
+		
+		_mass = PBField.new("mass", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _mass
+		data[_mass.tag] = service
+		
+	var data = {}
+	
+	var _player_id: PBField
+	func get_player_id() -> int:
+		return _player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		_player_id.value = value
+	
+	var _mass: PBField
+	func get_mass() -> float:
+		return _mass.value
+	func clear_mass() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_mass(value : float) -> void:
+		_mass.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class TeamScoreMessage:
+	func _init():
+		var service
+		
+		_team = PBField.new("team", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = _team
+		data[_team.tag] = service
+		
+		_name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _name
+		data[_name.tag] = service
+		
+		_color = PBField.new("color", PB_DATA_TYPE.INT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT32])
+		service = PBServiceField.new()
+		service.field = _color
+		data[_color.tag] = service
+		
+		_players = PBField.new("players", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _players
+		data[_players.tag] = service
+		
+		_score = PBField.new("score", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _score
+		data[_score.tag] = service
+		
+	var data = {}
+	
+	var _team: PBField
+	func get_team() -> int:
+		return _team.value
+	func clear_team() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_team.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_team(value : int) -> void:
+		_team.value = value
+	
+	var _name: PBField
+	func get_name() -> String:
+		return _name.value
+	func clear_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		_name.value = value
+	
+	var _color: PBField
+	func get_color() -> int:
+		return _color.value
+	func clear_color() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT32]
+	func set_color(value : int) -> void:
+		_color.value = value
+	
+	var _players: PBField
+	func get_players() -> int:
+		return _players.value
+	func clear_players() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_players(value : int) -> void:
+		_players.value = value
+	
+	var _score: PBField
+	func get_score() -> int:
+		return _score.value
+	func clear_score() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_score.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_score(value : int) -> void:
+		_score.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class TeamScoresMessage:
+	func _init():
+		var service
+		
+		_teams = PBField.new("teams", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, [])
+		service = PBServiceField.new()
+		service.field = _teams
+		service.func_ref = Callable(self, "add_teams")
+		data[_teams.tag] = service
+		
+	var data = {}
+	
+	var _teams: PBField
+	func get_teams() -> Array:
+		return _teams.value
+	func clear_teams() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_teams.value = []
+	func add_teams() -> TeamScoreMessage:
+		var element = TeamScoreMessage.new()
+		_teams.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class LeaderboardEntryMessage:
+	func _init():
+		var service
+		
+		_player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _player_id
+		data[_player_id.tag] = service
+		
+		_name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _name
+		data[_name.tag] = service
+		
+		_mass = PBField.new("mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _mass
+		data[_mass.tag] = service
+		
+	var data = {}
+	
+	var _player_id: PBField
+	func get_player_id() -> int:
+		return _player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		_player_id.value = value
+	
+	var _name: PBField
+	func get_name() -> String:
+		return _name.value
+	func clear_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		_name.value = value
+	
+	var _mass: PBField
+	func get_mass() -> int:
+		return _mass.value
+	func clear_mass() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_mass(value : int) -> void:
+		_mass.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class LeaderboardMessage:
+	func _init():
+		var service
+		
+		_entries = PBField.new("entries", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, [])
+		service = PBServiceField.new()
+		service.field = _entries
+		service.func_ref = Callable(self, "add_entries")
+		data[_entries.tag] = service
+		
+		_own_rank = PBField.new("own_rank", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _own_rank
+		data[_own_rank.tag] = service
+		
+		_own_mass = PBField.new("own_mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _own_mass
+		data[_own_mass.tag] = service
+		
+		_players = PBField.new("players", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _players
+		data[_players.tag] = service
+		
+	var data = {}
+	
+	var _entries: PBField
+	func get_entries() -> Array:
+		return _entries.value
+	func clear_entries() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_entries.value = []
+	func add_entries() -> LeaderboardEntryMessage:
+		var element = LeaderboardEntryMessage.new()
+		_entries.value.append(element)
+		return element
+	
+	var _own_rank: PBField
+	func get_own_rank() -> int:
+		return _own_rank.value
+	func clear_own_rank() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_own_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_own_rank(value : int) -> void:
+		_own_rank.value = value
+	
+	var _own_mass: PBField
+	func get_own_mass() -> int:
+		return _own_mass.value
+	func clear_own_mass() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_own_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_own_mass(value : int) -> void:
+		_own_mass.value = value
+	
+	var _players: PBField
+	func get_players() -> int:
+		return _players.value
+	func clear_players() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_players(value : int) -> void:
+		_players.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class KillFeedMessage:
+	func _init():
+		var service
+		
+		_killer_id = PBField.new("killer_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _killer_id
+		data[_killer_id.tag] = service
+		
+		_killer_name = PBField.new("killer_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _killer_name
+		data[_killer_name.tag] = service
+		
+		_victim_id = PBField.new("victim_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _victim_id
+		data[_victim_id.tag] = service
+		
+		_victim_name = PBField.new("victim_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _victim_name
+		data[_victim_name.tag] = service
+		
+		_mass = PBField.new("mass", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _mass
+		data[_mass.tag] = service
+		
+	var data = {}
+	
+	var _killer_id: PBField
+	func get_killer_id() -> int:
+		return _killer_id.value
+	func clear_killer_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_killer_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_killer_id(value : int) -> void:
+		_killer_id.value = value
+	
+	var _killer_name: PBField
+	func get_killer_name() -> String:
+		return _killer_name.value
+	func clear_killer_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_killer_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_killer_name(value : String) -> void:
+		_killer_name.value = value
+	
+	var _victim_id: PBField
+	func get_victim_id() -> int:
+		return _victim_id.value
+	func clear_victim_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_victim_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_victim_id(value : int) -> void:
+		_victim_id.value = value
+	
+	var _victim_name: PBField
+	func get_victim_name() -> String:
+		return _victim_name.value
+	func clear_victim_name() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_victim_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_victim_name(value : String) -> void:
+		_victim_name.value = value
+	
+	var _mass: PBField
+	func get_mass() -> float:
+		return _mass.value
+	func clear_mass() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_mass(value : float) -> void:
+		_mass.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class AnnouncementMessage:
+	func _init():
+		var service
+		
+		_kind = PBField.new("kind", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _kind
+		data[_kind.tag] = service
+		
+		_player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _player_id
+		data[_player_id.tag] = service
+		
+		_text = PBField.new("text", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _text
+		data[_text.tag] = service
+		
+	var data = {}
+	
+	var _kind: PBField
+	func get_kind() -> String:
+		return _kind.value
+	func clear_kind() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_kind.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_kind(value : String) -> void:
+		_kind.value = value
+	
+	var _player_id: PBField
+	func get_player_id() -> int:
+		return _player_id.value
+	func clear_player_id() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		_player_id.value = value
+	
+	var _text: PBField
+	func get_text() -> String:
+		return _text.value
+	func clear_text() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_text.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_text(value : String) -> void:
+		_text.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ComboMessage:
+	func _init():
+		var service
+		
+		_combo = PBField.new("combo", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = _combo
+		data[_combo.tag] = service
+		
+		_multiplier = PBField.new("multiplier", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _multiplier
+		data[_multiplier.tag] = service
+		
+	var data = {}
+	
+	var _combo: PBField
+	func get_combo() -> int:
+		return _combo.value
+	func clear_combo() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_combo(value : int) -> void:
+		_combo.value = value
+	
+	var _multiplier: PBField
+	func get_multiplier() -> float:
+		return _multiplier.value
+	func clear_multiplier() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_multiplier.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_multiplier(value : float) -> void:
+		_multiplier.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SporesExpiredMessage:
+	func _init():
+		var service
+		
+		_spore_ids = PBField.new("spore_ids", PB_DATA_TYPE.UINT64, PB_RULE.REPEATED, 1, true, [])
+		service = PBServiceField.new()
+		service.field = _spore_ids
+		data[_spore_ids.tag] = service
+		
+	var data = {}
+	
+	var _spore_ids: PBField
+	func get_spore_ids() -> Array:
+		return _spore_ids.value
+	func clear_spore_ids() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_spore_ids.value = []
+	func add_spore_ids(value : int) -> void:
+		_spore_ids.value.append(value)
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ZoneMessage:
+	func _init():
+		var service
+		
+		_zone_id = PBField.new("zone_id", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = _zone_id
+		data[_zone_id.tag] = service
+		
+		_state = PBField.new("state", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = _state
+		data[_state.tag] = service
+		
+		_holder_id = PBField.new("holder_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _holder_id
+		data[_holder_id.tag] = service
+		
+		_holder_name = PBField.new("holder_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _holder_name
+		data[_holder_name.tag] = service
+		
+	var data = {}
+	
+	var _zone_id: PBField
+	func get_zone_id() -> int:
+		return _zone_id.value
+	func clear_zone_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_zone_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_zone_id(value : int) -> void:
+		_zone_id.value = value
+	
+	var _state: PBField
+	func get_state():
+		return _state.value
+	func clear_state() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_state.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_state(value) -> void:
+		_state.value = value
+	
+	var _holder_id: PBField
+	func get_holder_id() -> int:
+		return _holder_id.value
+	func clear_holder_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_holder_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_holder_id(value : int) -> void:
+		_holder_id.value = value
+	
+	var _holder_name: PBField
+	func get_holder_name() -> String:
+		return _holder_name.value
+	func clear_holder_name() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_holder_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_holder_name(value : String) -> void:
+		_holder_name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RegionMessage:
+	func _init():
+		var service
+		
+		_x = PBField.new("x", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _x
+		data[_x.tag] = service
+		
+		_y = PBField.new("y", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _y
+		data[_y.tag] = service
+		
+		_width = PBField.new("width", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _width
+		data[_width.tag] = service
+		
+		_height = PBField.new("height", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _height
+		data[_height.tag] = service
+		
+		_weight = PBField.new("weight", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _weight
+		data[_weight.tag] = service
+		
+	var data = {}
+	
+	var _x: PBField
+	func get_x() -> float:
+		return _x.value
+	func clear_x() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_x(value : float) -> void:
+		_x.value = value
+	
+	var _y: PBField
+	func get_y() -> float:
+		return _y.value
+	func clear_y() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_y(value : float) -> void:
+		_y.value = value
+	
+	var _width: PBField
+	func get_width() -> float:
+		return _width.value
+	func clear_width() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_width.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_width(value : float) -> void:
+		_width.value = value
+	
+	var _height: PBField
+	func get_height() -> float:
+		return _height.value
+	func clear_height() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_height.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_height(value : float) -> void:
+		_height.value = value
+	
+	var _weight: PBField
+	func get_weight() -> float:
+		return _weight.value
+	func clear_weight() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_weight.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_weight(value : float) -> void:
+		_weight.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class WorldMessage:
+	func _init():
+		var service
+		
+		_shape = PBField.new("shape", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = _shape
+		data[_shape.tag] = service
+		
+		_size = PBField.new("size", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = _size
+		data[_size.tag] = service
+		
+		_map_name = PBField.new("map_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = _map_name
+		data[_map_name.tag] = service
+		
+		_obstacles = PBField.new("obstacles", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 4, true, [])
+		service = PBServiceField.new()
+		service.field = _obstacles
+		service.func_ref = Callable(self, "add_obstacles")
+		data[_obstacles.tag] = service
+		
+		_spawn_zones = PBField.new("spawn_zones", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 5, true, [])
+		service = PBServiceField.new()
+		service.field = _spawn_zones
+		service.func_ref = Callable(self, "add_spawn_zones")
+		data[_spawn_zones.tag] = service
+		
+		_spore_regions = PBField.new("spore_regions", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 6, true, [])
+		service = PBServiceField.new()
+		service.field = _spore_regions
+		service.func_ref = Callable(self, "add_spore_regions")
+		data[_spore_regions.tag] = service
+		
+		_control_zones = PBField.new("control_zones", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 7, true, [])
+		service = PBServiceField.new()
+		service.field = _control_zones
+		service.func_ref = Callable(self, "add_control_zones")
+		data[_control_zones.tag] = service
+		
+	var data = {}
+	
+	var _shape: PBField
+	func get_shape():
+		return _shape.value
+	func clear_shape() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_shape.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_shape(value) -> void:
+		_shape.value = value
+	
+	var _size: PBField
+	func get_size() -> float:
+		return _size.value
+	func clear_size() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_size.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_size(value : float) -> void:
+		_size.value = value
+	
+	var _map_name: PBField
+	func get_map_name() -> String:
+		return _map_name.value
+	func clear_map_name() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_map_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_map_name(value : String) -> void:
+		_map_name.value = value
+	
+	var _obstacles: PBField
+	func get_obstacles() -> Array:
+		return _obstacles.value
+	func clear_obstacles() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_obstacles.value = []
+	func add_obstacles() -> RegionMessage:
+		var element = RegionMessage.new()
+		_obstacles.value.append(element)
+		return element
+	
+	var _spawn_zones: PBField
+	func get_spawn_zones() -> Array:
+		return _spawn_zones.value
+	func clear_spawn_zones() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_spawn_zones.value = []
+	func add_spawn_zones() -> RegionMessage:
+		var element = RegionMessage.new()
+		_spawn_zones.value.append(element)
+		return element
+	
+	var _spore_regions: PBField
+	func get_spore_regions() -> Array:
+		return _spore_regions.value
+	func clear_spore_regions() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_spore_regions.value = []
+	func add_spore_regions() -> RegionMessage:
+		var element = RegionMessage.new()
+		_spore_regions.value.append(element)
+		return element
+	
+	var _control_zones: PBField
+	func get_control_zones() -> Array:
+		return _control_zones.value
+	func clear_control_zones() -> void:
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_control_zones.value = []
+	func add_control_zones() -> RegionMessage:
+		var element = RegionMessage.new()
+		_control_zones.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class Packet:
+	func _init():
+		var service
+		
+		_sender_id = PBField.new("sender_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = _sender_id
+		data[_sender_id.tag] = service
+		
+		_chat = PBField.new("chat", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _chat
+		service.func_ref = Callable(self, "new_chat")
+		data[_chat.tag] = service
+		
+		_id = PBField.new("id", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _id
+		service.func_ref = Callable(self, "new_id")
+		data[_id.tag] = service
+		
+		_login_request = PBField.new("login_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _login_request
+		service.func_ref = Callable(self, "new_login_request")
+		data[_login_request.tag] = service
+		
+		_register_request = PBField.new("register_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _register_request
+		service.func_ref = Callable(self, "new_register_request")
+		data[_register_request.tag] = service
+		
+		_ok_response = PBField.new("ok_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _ok_response
+		service.func_ref = Callable(self, "new_ok_response")
+		data[_ok_response.tag] = service
+		
+		_deny_response = PBField.new("deny_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _deny_response
+		service.func_ref = Callable(self, "new_deny_response")
+		data[_deny_response.tag] = service
+		
+		_player = PBField.new("player", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 8, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _player
+		service.func_ref = Callable(self, "new_player")
+		data[_player.tag] = service
+		
+		_player_direction = PBField.new("player_direction", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _player_direction
+		service.func_ref = Callable(self, "new_player_direction")
+		data[_player_direction.tag] = service
+		
+		_spore = PBField.new("spore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 10, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _spore
+		service.func_ref = Callable(self, "new_spore")
+		data[_spore.tag] = service
+		
+		_spore_consumed = PBField.new("spore_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 11, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _spore_consumed
+		service.func_ref = Callable(self, "new_spore_consumed")
+		data[_spore_consumed.tag] = service
+		
+		_spores_batch = PBField.new("spores_batch", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 12, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _spores_batch
+		service.func_ref = Callable(self, "new_spores_batch")
+		data[_spores_batch.tag] = service
+		
+		_player_consumed = PBField.new("player_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 13, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _player_consumed
+		service.func_ref = Callable(self, "new_player_consumed")
+		data[_player_consumed.tag] = service
+		
+		_hiscore_board_request = PBField.new("hiscore_board_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 14, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _hiscore_board_request
+		service.func_ref = Callable(self, "new_hiscore_board_request")
+		data[_hiscore_board_request.tag] = service
+		
+		_hiscore = PBField.new("hiscore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 15, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _hiscore
+		service.func_ref = Callable(self, "new_hiscore")
+		data[_hiscore.tag] = service
+		
+		_hiscore_board = PBField.new("hiscore_board", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 16, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _hiscore_board
+		service.func_ref = Callable(self, "new_hiscore_board")
+		data[_hiscore_board.tag] = service
+		
+		_finished_browsing_hiscores = PBField.new("finished_browsing_hiscores", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 17, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _finished_browsing_hiscores
+		service.func_ref = Callable(self, "new_finished_browsing_hiscores")
+		data[_finished_browsing_hiscores.tag] = service
+		
+		_search_hiscore = PBField.new("search_hiscore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 18, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _search_hiscore
+		service.func_ref = Callable(self, "new_search_hiscore")
+		data[_search_hiscore.tag] = service
+		
+		_disconnect = PBField.new("disconnect", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 19, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _disconnect
+		service.func_ref = Callable(self, "new_disconnect")
+		data[_disconnect.tag] = service
+		
+		_arena_list_request = PBField.new("arena_list_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 20, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _arena_list_request
+		service.func_ref = Callable(self, "new_arena_list_request")
+		data[_arena_list_request.tag] = service
+		
+		_arena_list = PBField.new("arena_list", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 21, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _arena_list
+		service.func_ref = Callable(self, "new_arena_list")
+		data[_arena_list.tag] = service
+		
+		_create_arena_request = PBField.new("create_arena_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 22, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _create_arena_request
+		service.func_ref = Callable(self, "new_create_arena_request")
+		data[_create_arena_request.tag] = service
+		
+		_arena_created = PBField.new("arena_created", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 23, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _arena_created
+		service.func_ref = Callable(self, "new_arena_created")
+		data[_arena_created.tag] = service
+		
+		_kick_player = PBField.new("kick_player", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 24, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _kick_player
+		service.func_ref = Callable(self, "new_kick_player")
+		data[_kick_player.tag] = service
+		
+		_spectate_request = PBField.new("spectate_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 25, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _spectate_request
+		service.func_ref = Callable(self, "new_spectate_request")
+		data[_spectate_request.tag] = service
+		
+		_spectating = PBField.new("spectating", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 26, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _spectating
+		service.func_ref = Callable(self, "new_spectating")
+		data[_spectating.tag] = service
+		
+		_finished_spectating = PBField.new("finished_spectating", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 27, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _finished_spectating
+		service.func_ref = Callable(self, "new_finished_spectating")
+		data[_finished_spectating.tag] = service
+		
+		_death_summary = PBField.new("death_summary", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 28, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _death_summary
+		service.func_ref = Callable(self, "new_death_summary")
+		data[_death_summary.tag] = service
+		
+		_respawn_request = PBField.new("respawn_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 29, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _respawn_request
+		service.func_ref = Callable(self, "new_respawn_request")
+		data[_respawn_request.tag] = service
+		
+		_split = PBField.new("split", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 30, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _split
+		service.func_ref = Callable(self, "new_split")
+		data[_split.tag] = service
+		
+		_cell_removed = PBField.new("cell_removed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 31, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _cell_removed
+		service.func_ref = Callable(self, "new_cell_removed")
+		data[_cell_removed.tag] = service
+		
+		_eject_mass = PBField.new("eject_mass", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 32, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _eject_mass
+		service.func_ref = Callable(self, "new_eject_mass")
+		data[_eject_mass.tag] = service
+		
+		_hazard = PBField.new("hazard", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 33, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _hazard
+		service.func_ref = Callable(self, "new_hazard")
+		data[_hazard.tag] = service
+		
+		_hazard_consumed = PBField.new("hazard_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 34, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _hazard_consumed
+		service.func_ref = Callable(self, "new_hazard_consumed")
+		data[_hazard_consumed.tag] = service
+		
+		_boost = PBField.new("boost", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 35, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _boost
+		service.func_ref = Callable(self, "new_boost")
+		data[_boost.tag] = service
+		
+		_world = PBField.new("world", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 36, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _world
+		service.func_ref = Callable(self, "new_world")
+		data[_world.tag] = service
+		
+		_power_up = PBField.new("power_up", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 37, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _power_up
+		service.func_ref = Callable(self, "new_power_up")
+		data[_power_up.tag] = service
+		
+		_power_up_collected = PBField.new("power_up_collected", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 38, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _power_up_collected
+		service.func_ref = Callable(self, "new_power_up_collected")
+		data[_power_up_collected.tag] = service
+		
+		_game_mode = PBField.new("game_mode", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 39, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _game_mode
+		service.func_ref = Callable(self, "new_game_mode")
+		data[_game_mode.tag] = service
+		
+		_safe_zone = PBField.new("safe_zone", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 40, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _safe_zone
+		service.func_ref = Callable(self, "new_safe_zone")
+		data[_safe_zone.tag] = service
+		
+		_round_over = PBField.new("round_over", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 41, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _round_over
+		service.func_ref = Callable(self, "new_round_over")
+		data[_round_over.tag] = service
+		
+		_mass_share = PBField.new("mass_share", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 42, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _mass_share
+		service.func_ref = Callable(self, "new_mass_share")
+		data[_mass_share.tag] = service
+		
+		_team_scores = PBField.new("team_scores", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 43, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _team_scores
+		service.func_ref = Callable(self, "new_team_scores")
+		data[_team_scores.tag] = service
+		
+		_zone = PBField.new("zone", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 44, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _zone
+		service.func_ref = Callable(self, "new_zone")
+		data[_zone.tag] = service
+		
+		_leaderboard = PBField.new("leaderboard", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 45, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _leaderboard
+		service.func_ref = Callable(self, "new_leaderboard")
+		data[_leaderboard.tag] = service
+		
+		_kill_feed = PBField.new("kill_feed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 46, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _kill_feed
+		service.func_ref = Callable(self, "new_kill_feed")
+		data[_kill_feed.tag] = service
+		
+		_announcement = PBField.new("announcement", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 47, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _announcement
+		service.func_ref = Callable(self, "new_announcement")
+		data[_announcement.tag] = service
+		
+		_combo = PBField.new("combo", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 48, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _combo
+		service.func_ref = Callable(self, "new_combo")
+		data[_combo.tag] = service
+		
+		_spores_expired = PBField.new("spores_expired", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 49, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = _spores_expired
+		service.func_ref = Callable(self, "new_spores_expired")
+		data[_spores_expired.tag] = service
+		
+	var data = {}
+	
+	var _sender_id: PBField
+	func get_sender_id() -> int:
+		return _sender_id.value
+	func clear_sender_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		_sender_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_sender_id(value : int) -> void:
+		_sender_id.value = value
+	
+	var _chat: PBField
+	func has_chat() -> bool:
+		return data[2].state == PB_SERVICE_STATE.FILLED
+	func get_chat() -> ChatMessage:
+		return _chat.value
+	func clear_chat() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_chat() -> ChatMessage:
+		data[2].state = PB_SERVICE_STATE.FILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_chat.value = ChatMessage.new()
+		return _chat.value
+	
+	var _id: PBField
+	func has_id() -> bool:
+		return data[3].state == PB_SERVICE_STATE.FILLED
+	func get_id() -> IdMessage:
+		return _id.value
+	func clear_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_id() -> IdMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		data[3].state = PB_SERVICE_STATE.FILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = IdMessage.new()
+		return _id.value
+	
+	var _login_request: PBField
+	func has_login_request() -> bool:
+		return data[4].state == PB_SERVICE_STATE.FILLED
+	func get_login_request() -> LoginRequestMessage:
+		return _login_request.value
+	func clear_login_request() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_login_request() -> LoginRequestMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		data[4].state = PB_SERVICE_STATE.FILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = LoginRequestMessage.new()
+		return _login_request.value
+	
+	var _register_request: PBField
+	func has_register_request() -> bool:
+		return data[5].state == PB_SERVICE_STATE.FILLED
+	func get_register_request() -> RegisterRequestMessage:
+		return _register_request.value
+	func clear_register_request() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_register_request() -> RegisterRequestMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		data[5].state = PB_SERVICE_STATE.FILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = RegisterRequestMessage.new()
+		return _register_request.value
+	
+	var _ok_response: PBField
+	func has_ok_response() -> bool:
+		return data[6].state == PB_SERVICE_STATE.FILLED
+	func get_ok_response() -> OkResponseMessage:
+		return _ok_response.value
+	func clear_ok_response() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_ok_response() -> OkResponseMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		data[6].state = PB_SERVICE_STATE.FILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = OkResponseMessage.new()
+		return _ok_response.value
+	
+	var _deny_response: PBField
+	func has_deny_response() -> bool:
+		return data[7].state == PB_SERVICE_STATE.FILLED
+	func get_deny_response() -> DenyResponseMessage:
+		return _deny_response.value
+	func clear_deny_response() -> void:
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_deny_response() -> DenyResponseMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		data[7].state = PB_SERVICE_STATE.FILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DenyResponseMessage.new()
+		return _deny_response.value
+	
+	var _player: PBField
+	func has_player() -> bool:
+		return data[8].state == PB_SERVICE_STATE.FILLED
+	func get_player() -> PlayerMessage:
+		return _player.value
+	func clear_player() -> void:
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player() -> PlayerMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		data[8].state = PB_SERVICE_STATE.FILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = PlayerMessage.new()
+		return _player.value
+	
+	var _player_direction: PBField
+	func has_player_direction() -> bool:
+		return data[9].state == PB_SERVICE_STATE.FILLED
+	func get_player_direction() -> PlayerDirectionMessage:
+		return _player_direction.value
+	func clear_player_direction() -> void:
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_direction() -> PlayerDirectionMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		data[9].state = PB_SERVICE_STATE.FILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = PlayerDirectionMessage.new()
+		return _player_direction.value
+	
+	var _spore: PBField
+	func has_spore() -> bool:
+		return data[10].state == PB_SERVICE_STATE.FILLED
+	func get_spore() -> SporeMessage:
+		return _spore.value
+	func clear_spore() -> void:
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spore() -> SporeMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		data[10].state = PB_SERVICE_STATE.FILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = SporeMessage.new()
+		return _spore.value
+	
+	var _spore_consumed: PBField
+	func has_spore_consumed() -> bool:
+		return data[11].state == PB_SERVICE_STATE.FILLED
+	func get_spore_consumed() -> SporeConsumedMessage:
+		return _spore_consumed.value
+	func clear_spore_consumed() -> void:
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spore_consumed() -> SporeConsumedMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		data[11].state = PB_SERVICE_STATE.FILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = SporeConsumedMessage.new()
+		return _spore_consumed.value
+	
+	var _spores_batch: PBField
+	func has_spores_batch() -> bool:
+		return data[12].state == PB_SERVICE_STATE.FILLED
+	func get_spores_batch() -> SporesBatchMessage:
+		return _spores_batch.value
+	func clear_spores_batch() -> void:
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spores_batch() -> SporesBatchMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		data[12].state = PB_SERVICE_STATE.FILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = SporesBatchMessage.new()
+		return _spores_batch.value
+	
+	var _player_consumed: PBField
+	func has_player_consumed() -> bool:
+		return data[13].state == PB_SERVICE_STATE.FILLED
+	func get_player_consumed() -> PlayerConsumedMessage:
+		return _player_consumed.value
+	func clear_player_consumed() -> void:
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_consumed() -> PlayerConsumedMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		data[13].state = PB_SERVICE_STATE.FILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = PlayerConsumedMessage.new()
+		return _player_consumed.value
+	
+	var _hiscore_board_request: PBField
+	func has_hiscore_board_request() -> bool:
+		return data[14].state == PB_SERVICE_STATE.FILLED
+	func get_hiscore_board_request() -> HiscoreBoardRequestMessage:
+		return _hiscore_board_request.value
+	func clear_hiscore_board_request() -> void:
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_hiscore_board_request() -> HiscoreBoardRequestMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		data[14].state = PB_SERVICE_STATE.FILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = HiscoreBoardRequestMessage.new()
+		return _hiscore_board_request.value
+	
+	var _hiscore: PBField
+	func has_hiscore() -> bool:
+		return data[15].state == PB_SERVICE_STATE.FILLED
+	func get_hiscore() -> HiscoreMessage:
+		return _hiscore.value
+	func clear_hiscore() -> void:
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_hiscore() -> HiscoreMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		data[15].state = PB_SERVICE_STATE.FILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = HiscoreMessage.new()
+		return _hiscore.value
+	
+	var _hiscore_board: PBField
+	func has_hiscore_board() -> bool:
+		return data[16].state == PB_SERVICE_STATE.FILLED
+	func get_hiscore_board() -> HiscoreBoardMessage:
+		return _hiscore_board.value
+	func clear_hiscore_board() -> void:
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_hiscore_board() -> HiscoreBoardMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		data[16].state = PB_SERVICE_STATE.FILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = HiscoreBoardMessage.new()
+		return _hiscore_board.value
+	
+	var _finished_browsing_hiscores: PBField
+	func has_finished_browsing_hiscores() -> bool:
+		return data[17].state == PB_SERVICE_STATE.FILLED
+	func get_finished_browsing_hiscores() -> FinishedBrowsingHiscoresMessage:
+		return _finished_browsing_hiscores.value
+	func clear_finished_browsing_hiscores() -> void:
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_finished_browsing_hiscores() -> FinishedBrowsingHiscoresMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		data[17].state = PB_SERVICE_STATE.FILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = FinishedBrowsingHiscoresMessage.new()
+		return _finished_browsing_hiscores.value
+	
+	var _search_hiscore: PBField
+	func has_search_hiscore() -> bool:
+		return data[18].state == PB_SERVICE_STATE.FILLED
+	func get_search_hiscore() -> SearchHiscoreMessage:
+		return _search_hiscore.value
+	func clear_search_hiscore() -> void:
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_search_hiscore() -> SearchHiscoreMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		data[18].state = PB_SERVICE_STATE.FILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = SearchHiscoreMessage.new()
+		return _search_hiscore.value
+	
+	var _disconnect: PBField
+	func has_disconnect() -> bool:
+		return data[19].state == PB_SERVICE_STATE.FILLED
+	func get_disconnect() -> DisconnectMessage:
+		return _disconnect.value
+	func clear_disconnect() -> void:
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_disconnect() -> DisconnectMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		data[19].state = PB_SERVICE_STATE.FILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DisconnectMessage.new()
+		return _disconnect.value
+	
+	var _arena_list_request: PBField
+	func has_arena_list_request() -> bool:
+		return data[20].state == PB_SERVICE_STATE.FILLED
+	func get_arena_list_request() -> ArenaListRequestMessage:
+		return _arena_list_request.value
+	func clear_arena_list_request() -> void:
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_arena_list_request() -> ArenaListRequestMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		data[20].state = PB_SERVICE_STATE.FILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = ArenaListRequestMessage.new()
+		return _arena_list_request.value
+	
+	var _arena_list: PBField
+	func has_arena_list() -> bool:
+		return data[21].state == PB_SERVICE_STATE.FILLED
+	func get_arena_list() -> ArenaListMessage:
+		return _arena_list.value
+	func clear_arena_list() -> void:
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_arena_list() -> ArenaListMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		data[21].state = PB_SERVICE_STATE.FILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = ArenaListMessage.new()
+		return _arena_list.value
+	
+	var _create_arena_request: PBField
+	func has_create_arena_request() -> bool:
+		return data[22].state == PB_SERVICE_STATE.FILLED
+	func get_create_arena_request() -> CreateArenaRequestMessage:
+		return _create_arena_request.value
+	func clear_create_arena_request() -> void:
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_create_arena_request() -> CreateArenaRequestMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		data[22].state = PB_SERVICE_STATE.FILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = CreateArenaRequestMessage.new()
+		return _create_arena_request.value
+	
+	var _arena_created: PBField
+	func has_arena_created() -> bool:
+		return data[23].state == PB_SERVICE_STATE.FILLED
+	func get_arena_created() -> ArenaCreatedMessage:
+		return _arena_created.value
+	func clear_arena_created() -> void:
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_arena_created() -> ArenaCreatedMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		data[23].state = PB_SERVICE_STATE.FILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = ArenaCreatedMessage.new()
+		return _arena_created.value
+	
+	var _kick_player: PBField
+	func has_kick_player() -> bool:
+		return data[24].state == PB_SERVICE_STATE.FILLED
+	func get_kick_player() -> KickPlayerMessage:
+		return _kick_player.value
+	func clear_kick_player() -> void:
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_kick_player() -> KickPlayerMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		data[24].state = PB_SERVICE_STATE.FILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = KickPlayerMessage.new()
+		return _kick_player.value
+	
+	var _spectate_request: PBField
+	func has_spectate_request() -> bool:
+		return data[25].state == PB_SERVICE_STATE.FILLED
+	func get_spectate_request() -> SpectateRequestMessage:
+		return _spectate_request.value
+	func clear_spectate_request() -> void:
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spectate_request() -> SpectateRequestMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		data[25].state = PB_SERVICE_STATE.FILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = SpectateRequestMessage.new()
+		return _spectate_request.value
+	
+	var _spectating: PBField
+	func has_spectating() -> bool:
+		return data[26].state == PB_SERVICE_STATE.FILLED
+	func get_spectating() -> SpectatingMessage:
+		return _spectating.value
+	func clear_spectating() -> void:
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spectating() -> SpectatingMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		data[26].state = PB_SERVICE_STATE.FILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = SpectatingMessage.new()
+		return _spectating.value
+	
+	var _finished_spectating: PBField
+	func has_finished_spectating() -> bool:
+		return data[27].state == PB_SERVICE_STATE.FILLED
+	func get_finished_spectating() -> FinishedSpectatingMessage:
+		return _finished_spectating.value
+	func clear_finished_spectating() -> void:
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_finished_spectating() -> FinishedSpectatingMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		data[27].state = PB_SERVICE_STATE.FILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = FinishedSpectatingMessage.new()
+		return _finished_spectating.value
+	
+	var _death_summary: PBField
+	func has_death_summary() -> bool:
+		return data[28].state == PB_SERVICE_STATE.FILLED
+	func get_death_summary() -> DeathSummaryMessage:
+		return _death_summary.value
+	func clear_death_summary() -> void:
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_death_summary() -> DeathSummaryMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		data[28].state = PB_SERVICE_STATE.FILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DeathSummaryMessage.new()
+		return _death_summary.value
+	
+	var _respawn_request: PBField
+	func has_respawn_request() -> bool:
+		return data[29].state == PB_SERVICE_STATE.FILLED
+	func get_respawn_request() -> RespawnRequestMessage:
+		return _respawn_request.value
+	func clear_respawn_request() -> void:
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_respawn_request() -> RespawnRequestMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		data[29].state = PB_SERVICE_STATE.FILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = RespawnRequestMessage.new()
+		return _respawn_request.value
+	
+	var _split: PBField
+	func has_split() -> bool:
+		return data[30].state == PB_SERVICE_STATE.FILLED
+	func get_split() -> SplitMessage:
+		return _split.value
+	func clear_split() -> void:
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_split() -> SplitMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		data[30].state = PB_SERVICE_STATE.FILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = SplitMessage.new()
+		return _split.value
+	
+	var _cell_removed: PBField
+	func has_cell_removed() -> bool:
+		return data[31].state == PB_SERVICE_STATE.FILLED
+	func get_cell_removed() -> CellRemovedMessage:
+		return _cell_removed.value
+	func clear_cell_removed() -> void:
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_cell_removed() -> CellRemovedMessage:
+		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		data[31].state = PB_SERVICE_STATE.FILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = CellRemovedMessage.new()
+		return _cell_removed.value
 	
-	var _chat: PBField
-	func has_chat() -> bool:
-		return data[2].state == PB_SERVICE_STATE.FILLED
-	func get_chat() -> ChatMessage:
-		return _chat.value
-	func clear_chat() -> void:
-		data[2].state = PB_SERVICE_STATE.UNFILLED
+	var _eject_mass: PBField
+	func has_eject_mass() -> bool:
+		return data[32].state == PB_SERVICE_STATE.FILLED
+	func get_eject_mass() -> EjectMassMessage:
+		return _eject_mass.value
+	func clear_eject_mass() -> void:
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_eject_mass() -> EjectMassMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_chat() -> ChatMessage:
-		data[2].state = PB_SERVICE_STATE.FILLED
+		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[3].state = PB_SERVICE_STATE.UNFILLED
 		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1745,21 +7744,81 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_chat.value = ChatMessage.new()
-		return _chat.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		data[32].state = PB_SERVICE_STATE.FILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = EjectMassMessage.new()
+		return _eject_mass.value
 	
-	var _id: PBField
-	func has_id() -> bool:
-		return data[3].state == PB_SERVICE_STATE.FILLED
-	func get_id() -> IdMessage:
-		return _id.value
-	func clear_id() -> void:
-		data[3].state = PB_SERVICE_STATE.UNFILLED
-		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_id() -> IdMessage:
+	var _hazard: PBField
+	func has_hazard() -> bool:
+		return data[33].state == PB_SERVICE_STATE.FILLED
+	func get_hazard() -> HazardMessage:
+		return _hazard.value
+	func clear_hazard() -> void:
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_hazard() -> HazardMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
-		data[3].state = PB_SERVICE_STATE.FILLED
+		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
 		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[4].state = PB_SERVICE_STATE.UNFILLED
 		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1792,23 +7851,83 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_id.value = IdMessage.new()
-		return _id.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		data[33].state = PB_SERVICE_STATE.FILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = HazardMessage.new()
+		return _hazard.value
 	
-	var _login_request: PBField
-	func has_login_request() -> bool:
-		return data[4].state == PB_SERVICE_STATE.FILLED
-	func get_login_request() -> LoginRequestMessage:
-		return _login_request.value
-	func clear_login_request() -> void:
-		data[4].state = PB_SERVICE_STATE.UNFILLED
-		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_login_request() -> LoginRequestMessage:
+	var _hazard_consumed: PBField
+	func has_hazard_consumed() -> bool:
+		return data[34].state == PB_SERVICE_STATE.FILLED
+	func get_hazard_consumed() -> HazardConsumedMessage:
+		return _hazard_consumed.value
+	func clear_hazard_consumed() -> void:
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_hazard_consumed() -> HazardConsumedMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[3].state = PB_SERVICE_STATE.UNFILLED
-		data[4].state = PB_SERVICE_STATE.FILLED
+		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
 		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[5].state = PB_SERVICE_STATE.UNFILLED
 		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1839,25 +7958,85 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_login_request.value = LoginRequestMessage.new()
-		return _login_request.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		data[34].state = PB_SERVICE_STATE.FILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = HazardConsumedMessage.new()
+		return _hazard_consumed.value
 	
-	var _register_request: PBField
-	func has_register_request() -> bool:
-		return data[5].state == PB_SERVICE_STATE.FILLED
-	func get_register_request() -> RegisterRequestMessage:
-		return _register_request.value
-	func clear_register_request() -> void:
-		data[5].state = PB_SERVICE_STATE.UNFILLED
-		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_register_request() -> RegisterRequestMessage:
+	var _boost: PBField
+	func has_boost() -> bool:
+		return data[35].state == PB_SERVICE_STATE.FILLED
+	func get_boost() -> BoostMessage:
+		return _boost.value
+	func clear_boost() -> void:
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_boost() -> BoostMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[3].state = PB_SERVICE_STATE.UNFILLED
 		_login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[4].state = PB_SERVICE_STATE.UNFILLED
-		data[5].state = PB_SERVICE_STATE.FILLED
+		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
 		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[6].state = PB_SERVICE_STATE.UNFILLED
 		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1886,18 +8065,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_register_request.value = RegisterRequestMessage.new()
-		return _register_request.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		data[35].state = PB_SERVICE_STATE.FILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = BoostMessage.new()
+		return _boost.value
 	
-	var _ok_response: PBField
-	func has_ok_response() -> bool:
-		return data[6].state == PB_SERVICE_STATE.FILLED
-	func get_ok_response() -> OkResponseMessage:
-		return _ok_response.value
-	func clear_ok_response() -> void:
-		data[6].state = PB_SERVICE_STATE.UNFILLED
-		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_ok_response() -> OkResponseMessage:
+	var _world: PBField
+	func has_world() -> bool:
+		return data[36].state == PB_SERVICE_STATE.FILLED
+	func get_world() -> WorldMessage:
+		return _world.value
+	func clear_world() -> void:
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_world() -> WorldMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1906,7 +8144,8 @@ class Packet:
 		data[4].state = PB_SERVICE_STATE.UNFILLED
 		_register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[5].state = PB_SERVICE_STATE.UNFILLED
-		data[6].state = PB_SERVICE_STATE.FILLED
+		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
 		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[7].state = PB_SERVICE_STATE.UNFILLED
 		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1933,18 +8172,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_ok_response.value = OkResponseMessage.new()
-		return _ok_response.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		data[36].state = PB_SERVICE_STATE.FILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = WorldMessage.new()
+		return _world.value
 	
-	var _deny_response: PBField
-	func has_deny_response() -> bool:
-		return data[7].state == PB_SERVICE_STATE.FILLED
-	func get_deny_response() -> DenyResponseMessage:
-		return _deny_response.value
-	func clear_deny_response() -> void:
-		data[7].state = PB_SERVICE_STATE.UNFILLED
-		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_deny_response() -> DenyResponseMessage:
+	var _power_up: PBField
+	func has_power_up() -> bool:
+		return data[37].state == PB_SERVICE_STATE.FILLED
+	func get_power_up() -> PowerUpMessage:
+		return _power_up.value
+	func clear_power_up() -> void:
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_power_up() -> PowerUpMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1955,7 +8253,8 @@ class Packet:
 		data[5].state = PB_SERVICE_STATE.UNFILLED
 		_ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[6].state = PB_SERVICE_STATE.UNFILLED
-		data[7].state = PB_SERVICE_STATE.FILLED
+		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
 		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[8].state = PB_SERVICE_STATE.UNFILLED
 		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1980,18 +8279,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_deny_response.value = DenyResponseMessage.new()
-		return _deny_response.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		data[37].state = PB_SERVICE_STATE.FILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = PowerUpMessage.new()
+		return _power_up.value
 	
-	var _player: PBField
-	func has_player() -> bool:
-		return data[8].state == PB_SERVICE_STATE.FILLED
-	func get_player() -> PlayerMessage:
-		return _player.value
-	func clear_player() -> void:
-		data[8].state = PB_SERVICE_STATE.UNFILLED
-		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player() -> PlayerMessage:
+	var _power_up_collected: PBField
+	func has_power_up_collected() -> bool:
+		return data[38].state == PB_SERVICE_STATE.FILLED
+	func get_power_up_collected() -> PowerUpCollectedMessage:
+		return _power_up_collected.value
+	func clear_power_up_collected() -> void:
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_power_up_collected() -> PowerUpCollectedMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2004,7 +8362,8 @@ class Packet:
 		data[6].state = PB_SERVICE_STATE.UNFILLED
 		_deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[7].state = PB_SERVICE_STATE.UNFILLED
-		data[8].state = PB_SERVICE_STATE.FILLED
+		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
 		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[9].state = PB_SERVICE_STATE.UNFILLED
 		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2027,18 +8386,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_player.value = PlayerMessage.new()
-		return _player.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		data[38].state = PB_SERVICE_STATE.FILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = PowerUpCollectedMessage.new()
+		return _power_up_collected.value
 	
-	var _player_direction: PBField
-	func has_player_direction() -> bool:
-		return data[9].state == PB_SERVICE_STATE.FILLED
-	func get_player_direction() -> PlayerDirectionMessage:
-		return _player_direction.value
-	func clear_player_direction() -> void:
-		data[9].state = PB_SERVICE_STATE.UNFILLED
-		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player_direction() -> PlayerDirectionMessage:
+	var _game_mode: PBField
+	func has_game_mode() -> bool:
+		return data[39].state == PB_SERVICE_STATE.FILLED
+	func get_game_mode() -> GameModeMessage:
+		return _game_mode.value
+	func clear_game_mode() -> void:
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_game_mode() -> GameModeMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2053,7 +8471,8 @@ class Packet:
 		data[7].state = PB_SERVICE_STATE.UNFILLED
 		_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[8].state = PB_SERVICE_STATE.UNFILLED
-		data[9].state = PB_SERVICE_STATE.FILLED
+		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
 		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[10].state = PB_SERVICE_STATE.UNFILLED
 		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2074,18 +8493,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_player_direction.value = PlayerDirectionMessage.new()
-		return _player_direction.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		data[39].state = PB_SERVICE_STATE.FILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = GameModeMessage.new()
+		return _game_mode.value
 	
-	var _spore: PBField
-	func has_spore() -> bool:
-		return data[10].state == PB_SERVICE_STATE.FILLED
-	func get_spore() -> SporeMessage:
-		return _spore.value
-	func clear_spore() -> void:
-		data[10].state = PB_SERVICE_STATE.UNFILLED
-		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spore() -> SporeMessage:
+	var _safe_zone: PBField
+	func has_safe_zone() -> bool:
+		return data[40].state == PB_SERVICE_STATE.FILLED
+	func get_safe_zone() -> SafeZoneMessage:
+		return _safe_zone.value
+	func clear_safe_zone() -> void:
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_safe_zone() -> SafeZoneMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2102,7 +8580,8 @@ class Packet:
 		data[8].state = PB_SERVICE_STATE.UNFILLED
 		_player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[9].state = PB_SERVICE_STATE.UNFILLED
-		data[10].state = PB_SERVICE_STATE.FILLED
+		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
 		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[11].state = PB_SERVICE_STATE.UNFILLED
 		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2121,18 +8600,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_spore.value = SporeMessage.new()
-		return _spore.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		data[40].state = PB_SERVICE_STATE.FILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = SafeZoneMessage.new()
+		return _safe_zone.value
 	
-	var _spore_consumed: PBField
-	func has_spore_consumed() -> bool:
-		return data[11].state == PB_SERVICE_STATE.FILLED
-	func get_spore_consumed() -> SporeConsumedMessage:
-		return _spore_consumed.value
-	func clear_spore_consumed() -> void:
-		data[11].state = PB_SERVICE_STATE.UNFILLED
-		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spore_consumed() -> SporeConsumedMessage:
+	var _round_over: PBField
+	func has_round_over() -> bool:
+		return data[41].state == PB_SERVICE_STATE.FILLED
+	func get_round_over() -> RoundOverMessage:
+		return _round_over.value
+	func clear_round_over() -> void:
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_round_over() -> RoundOverMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2151,7 +8689,8 @@ class Packet:
 		data[9].state = PB_SERVICE_STATE.UNFILLED
 		_spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[10].state = PB_SERVICE_STATE.UNFILLED
-		data[11].state = PB_SERVICE_STATE.FILLED
+		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
 		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[12].state = PB_SERVICE_STATE.UNFILLED
 		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2168,18 +8707,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_spore_consumed.value = SporeConsumedMessage.new()
-		return _spore_consumed.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		data[41].state = PB_SERVICE_STATE.FILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = RoundOverMessage.new()
+		return _round_over.value
 	
-	var _spores_batch: PBField
-	func has_spores_batch() -> bool:
-		return data[12].state == PB_SERVICE_STATE.FILLED
-	func get_spores_batch() -> SporesBatchMessage:
-		return _spores_batch.value
-	func clear_spores_batch() -> void:
-		data[12].state = PB_SERVICE_STATE.UNFILLED
-		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spores_batch() -> SporesBatchMessage:
+	var _mass_share: PBField
+	func has_mass_share() -> bool:
+		return data[42].state == PB_SERVICE_STATE.FILLED
+	func get_mass_share() -> MassShareMessage:
+		return _mass_share.value
+	func clear_mass_share() -> void:
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_mass_share() -> MassShareMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2200,7 +8798,8 @@ class Packet:
 		data[10].state = PB_SERVICE_STATE.UNFILLED
 		_spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[11].state = PB_SERVICE_STATE.UNFILLED
-		data[12].state = PB_SERVICE_STATE.FILLED
+		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
 		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[13].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2215,18 +8814,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_spores_batch.value = SporesBatchMessage.new()
-		return _spores_batch.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		data[42].state = PB_SERVICE_STATE.FILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = MassShareMessage.new()
+		return _mass_share.value
 	
-	var _player_consumed: PBField
-	func has_player_consumed() -> bool:
-		return data[13].state == PB_SERVICE_STATE.FILLED
-	func get_player_consumed() -> PlayerConsumedMessage:
-		return _player_consumed.value
-	func clear_player_consumed() -> void:
-		data[13].state = PB_SERVICE_STATE.UNFILLED
-		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player_consumed() -> PlayerConsumedMessage:
+	var _team_scores: PBField
+	func has_team_scores() -> bool:
+		return data[43].state == PB_SERVICE_STATE.FILLED
+	func get_team_scores() -> TeamScoresMessage:
+		return _team_scores.value
+	func clear_team_scores() -> void:
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_team_scores() -> TeamScoresMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2249,7 +8907,8 @@ class Packet:
 		data[11].state = PB_SERVICE_STATE.UNFILLED
 		_spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[12].state = PB_SERVICE_STATE.UNFILLED
-		data[13].state = PB_SERVICE_STATE.FILLED
+		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2262,18 +8921,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_player_consumed.value = PlayerConsumedMessage.new()
-		return _player_consumed.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		data[43].state = PB_SERVICE_STATE.FILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = TeamScoresMessage.new()
+		return _team_scores.value
 	
-	var _hiscore_board_request: PBField
-	func has_hiscore_board_request() -> bool:
-		return data[14].state == PB_SERVICE_STATE.FILLED
-	func get_hiscore_board_request() -> HiscoreBoardRequestMessage:
-		return _hiscore_board_request.value
-	func clear_hiscore_board_request() -> void:
-		data[14].state = PB_SERVICE_STATE.UNFILLED
-		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_hiscore_board_request() -> HiscoreBoardRequestMessage:
+	var _zone: PBField
+	func has_zone() -> bool:
+		return data[44].state == PB_SERVICE_STATE.FILLED
+	func get_zone() -> ZoneMessage:
+		return _zone.value
+	func clear_zone() -> void:
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_zone() -> ZoneMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2298,7 +9016,8 @@ class Packet:
 		data[12].state = PB_SERVICE_STATE.UNFILLED
 		_player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[13].state = PB_SERVICE_STATE.UNFILLED
-		data[14].state = PB_SERVICE_STATE.FILLED
+		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2309,18 +9028,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_hiscore_board_request.value = HiscoreBoardRequestMessage.new()
-		return _hiscore_board_request.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		data[44].state = PB_SERVICE_STATE.FILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = ZoneMessage.new()
+		return _zone.value
 	
-	var _hiscore: PBField
-	func has_hiscore() -> bool:
-		return data[15].state == PB_SERVICE_STATE.FILLED
-	func get_hiscore() -> HiscoreMessage:
-		return _hiscore.value
-	func clear_hiscore() -> void:
-		data[15].state = PB_SERVICE_STATE.UNFILLED
-		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_hiscore() -> HiscoreMessage:
+	var _leaderboard: PBField
+	func has_leaderboard() -> bool:
+		return data[45].state == PB_SERVICE_STATE.FILLED
+	func get_leaderboard() -> LeaderboardMessage:
+		return _leaderboard.value
+	func clear_leaderboard() -> void:
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_leaderboard() -> LeaderboardMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2347,7 +9125,8 @@ class Packet:
 		data[13].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[14].state = PB_SERVICE_STATE.UNFILLED
-		data[15].state = PB_SERVICE_STATE.FILLED
+		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[16].state = PB_SERVICE_STATE.UNFILLED
 		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2356,18 +9135,77 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_hiscore.value = HiscoreMessage.new()
-		return _hiscore.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		data[45].state = PB_SERVICE_STATE.FILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = LeaderboardMessage.new()
+		return _leaderboard.value
 	
-	var _hiscore_board: PBField
-	func has_hiscore_board() -> bool:
-		return data[16].state == PB_SERVICE_STATE.FILLED
-	func get_hiscore_board() -> HiscoreBoardMessage:
-		return _hiscore_board.value
-	func clear_hiscore_board() -> void:
-		data[16].state = PB_SERVICE_STATE.UNFILLED
-		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_hiscore_board() -> HiscoreBoardMessage:
+	var _kill_feed: PBField
+	func has_kill_feed() -> bool:
+		return data[46].state == PB_SERVICE_STATE.FILLED
+	func get_kill_feed() -> KillFeedMessage:
+		return _kill_feed.value
+	func clear_kill_feed() -> void:
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_kill_feed() -> KillFeedMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2396,25 +9234,85 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		data[16].state = PB_SERVICE_STATE.FILLED
+		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
 		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[17].state = PB_SERVICE_STATE.UNFILLED
 		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_hiscore_board.value = HiscoreBoardMessage.new()
-		return _hiscore_board.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		data[46].state = PB_SERVICE_STATE.FILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = KillFeedMessage.new()
+		return _kill_feed.value
 	
-	var _finished_browsing_hiscores: PBField
-	func has_finished_browsing_hiscores() -> bool:
-		return data[17].state == PB_SERVICE_STATE.FILLED
-	func get_finished_browsing_hiscores() -> FinishedBrowsingHiscoresMessage:
-		return _finished_browsing_hiscores.value
-	func clear_finished_browsing_hiscores() -> void:
-		data[17].state = PB_SERVICE_STATE.UNFILLED
-		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_finished_browsing_hiscores() -> FinishedBrowsingHiscoresMessage:
+	var _announcement: PBField
+	func has_announcement() -> bool:
+		return data[47].state == PB_SERVICE_STATE.FILLED
+	func get_announcement() -> AnnouncementMessage:
+		return _announcement.value
+	func clear_announcement() -> void:
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_announcement() -> AnnouncementMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2445,23 +9343,83 @@ class Packet:
 		data[15].state = PB_SERVICE_STATE.UNFILLED
 		_hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[16].state = PB_SERVICE_STATE.UNFILLED
-		data[17].state = PB_SERVICE_STATE.FILLED
+		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
 		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_finished_browsing_hiscores.value = FinishedBrowsingHiscoresMessage.new()
-		return _finished_browsing_hiscores.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		data[47].state = PB_SERVICE_STATE.FILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = AnnouncementMessage.new()
+		return _announcement.value
 	
-	var _search_hiscore: PBField
-	func has_search_hiscore() -> bool:
-		return data[18].state == PB_SERVICE_STATE.FILLED
-	func get_search_hiscore() -> SearchHiscoreMessage:
-		return _search_hiscore.value
-	func clear_search_hiscore() -> void:
-		data[18].state = PB_SERVICE_STATE.UNFILLED
-		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_search_hiscore() -> SearchHiscoreMessage:
+	var _combo: PBField
+	func has_combo() -> bool:
+		return data[48].state == PB_SERVICE_STATE.FILLED
+	func get_combo() -> ComboMessage:
+		return _combo.value
+	func clear_combo() -> void:
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_combo() -> ComboMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2494,21 +9452,81 @@ class Packet:
 		data[16].state = PB_SERVICE_STATE.UNFILLED
 		_finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[17].state = PB_SERVICE_STATE.UNFILLED
-		data[18].state = PB_SERVICE_STATE.FILLED
+		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
 		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_search_hiscore.value = SearchHiscoreMessage.new()
-		return _search_hiscore.value
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		data[48].state = PB_SERVICE_STATE.FILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = ComboMessage.new()
+		return _combo.value
 	
-	var _disconnect: PBField
-	func has_disconnect() -> bool:
-		return data[19].state == PB_SERVICE_STATE.FILLED
-	func get_disconnect() -> DisconnectMessage:
-		return _disconnect.value
-	func clear_disconnect() -> void:
-		data[19].state = PB_SERVICE_STATE.UNFILLED
-		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_disconnect() -> DisconnectMessage:
+	var _spores_expired: PBField
+	func has_spores_expired() -> bool:
+		return data[49].state == PB_SERVICE_STATE.FILLED
+	func get_spores_expired() -> SporesExpiredMessage:
+		return _spores_expired.value
+	func clear_spores_expired() -> void:
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		_spores_expired.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spores_expired() -> SporesExpiredMessage:
 		_chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2543,9 +9561,69 @@ class Packet:
 		data[17].state = PB_SERVICE_STATE.UNFILLED
 		_search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[18].state = PB_SERVICE_STATE.UNFILLED
-		data[19].state = PB_SERVICE_STATE.FILLED
-		_disconnect.value = DisconnectMessage.new()
-		return _disconnect.value
+		_disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		_arena_list.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		_create_arena_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		_arena_created.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		_kick_player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		_spectate_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		_finished_spectating.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		_death_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		_respawn_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		_split.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		_cell_removed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		_eject_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		_hazard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		_hazard_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		_boost.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		_world.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		_power_up.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		_power_up_collected.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		_game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		_safe_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		_round_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		_mass_share.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		_team_scores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		_zone.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		_leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		_kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		_announcement.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		_combo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		data[49].state = PB_SERVICE_STATE.FILLED
+		_spores_expired.value = SporesExpiredMessage.new()
+		return _spores_expired.value
 	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
@@ -8,12 +8,21 @@ const Spore := preload("res://objects/spore/spore.gd")
 var _players: Dictionary
 var _spores: Dictionary
 
+# The cells each player has split off from their main body, by cell ID
+var _cells: Dictionary
+
+# The edge and obstacles of the world, laid out again whenever the server sends it
+var _world_layout: Node2D
+
 @onready var _logout_button: Button = $UI/MarginContainer/VBoxContainer/HBoxContainer/LogoutButton
 @onready var _send_button: Button = $UI/MarginContainer/VBoxContainer/HBoxContainer/SendButton
 @onready var _line_edit: LineEdit = $UI/MarginContainer/VBoxContainer/HBoxContainer/LineEdit
 @onready var _log: Log = $UI/MarginContainer/VBoxContainer/Log
 @onready var _hiscores: Hiscores = $UI/MarginContainer/VBoxContainer/Hiscores
 @onready var _world: Node2D = $World
+@onready var _death_screen: Control = $UI/DeathScreen
+@onready var _death_summary: Label = $UI/DeathScreen/PanelContainer/VBoxContainer/Summary
+@onready var _respawn_button: Button = $UI/DeathScreen/PanelContainer/VBoxContainer/RespawnButton
 
 func _ready() -> void:
 	WS.connection_closed.connect(_on_ws_connection_closed)
@@ -22,6 +31,7 @@ func _ready() -> void:
 	_logout_button.pressed.connect(_on_logout_button_pressed)
 	_send_button.pressed.connect(_on_send_button_pressed)
 	_line_edit.text_submitted.connect(_on_line_edit_text_submitted)
+	_respawn_button.pressed.connect(_on_respawn_button_pressed)
 
 func _handle_chat_msg(sender_id: int, chat_msg: packets.ChatMessage) -> void:
 	if sender_id in _players:
@@ -68,9 +78,30 @@ func _on_ws_packet_received(packet: packets.Packet) -> void:
 		_handle_spore_consumed_msg(sender_id, packet.get_spore_consumed())
 	elif packet.has_disconnect():
 		_handle_disconnect_msg(sender_id, packet.get_disconnect())
+	elif packet.has_world():
+		_handle_world_msg(sender_id, packet.get_world())
+	elif packet.has_player_consumed():
+		_handle_player_consumed_msg(sender_id, packet.get_player_consumed())
+	elif packet.has_cell_removed():
+		_handle_cell_removed_msg(sender_id, packet.get_cell_removed())
+	elif packet.has_death_summary():
+		_handle_death_summary_msg(sender_id, packet.get_death_summary())
+	elif packet.has_deny_response():
+		_log.error(packet.get_deny_response().get_reason())
+
+func _unhandled_input(event: InputEvent) -> void:
+	if event is InputEventKey and event.pressed and not event.echo and event.keycode == KEY_SPACE:
+		var packet := packets.Packet.new()
+		packet.new_split()
+		WS.send(packet)
 	
 func _handle_player_msg(sender_id: int, player_msg: packets.PlayerMessage) -> void:
 	var actor_id := player_msg.get_id()
+	var cell_id := player_msg.get_cell_id()
+	if cell_id != 0:
+		_handle_cell_msg(actor_id, cell_id, player_msg)
+		return
+	
 	var actor_name := player_msg.get_name()
 	var x := player_msg.get_x()
 	var y := player_msg.get_y()
@@ -92,11 +123,13 @@ func _add_actor(actor_id: int, actor_name: String, x: float, y: float, radius: f
 	var actor := Actor.instatiate(actor_id, actor_name, x, y, radius, speed, color, is_player)
 	_world.add_child(actor)
 	actor.z_index = 1
-	_set_actor_mass(actor, _rad_to_mass(radius))
 	_players[actor_id] = actor
+	_set_actor_mass(actor, _rad_to_mass(radius))
 	
 	if is_player:
-		actor.area_entered.connect(_on_player_area_entered)
+		actor.area_entered.connect(_on_player_area_entered.bind(actor))
+		# We're back in the game after respawning
+		_death_screen.hide()
 	
 func _update_actor(actor_id: int, actor_name: String, x: float, y: float, direction: float, radius: float, speed: float, is_player: bool) -> void:
 	# This is an existing player, so we need to update their position
@@ -111,6 +144,36 @@ func _update_actor(actor_id: int, actor_name: String, x: float, y: float, direct
 	if not is_player:
 		actor.velocity = speed * Vector2.from_angle(direction)
 
+func _handle_cell_msg(actor_id: int, cell_id: int, player_msg: packets.PlayerMessage) -> void:
+	# Split cells move on their own, so they're steered by the velocity the server gives them
+	var x := player_msg.get_x()
+	var y := player_msg.get_y()
+	var radius := player_msg.get_radius()
+	var velocity := Vector2(player_msg.get_vel_x(), player_msg.get_vel_y())
+	
+	if actor_id not in _cells:
+		_cells[actor_id] = {}
+	var cells: Dictionary = _cells[actor_id]
+	
+	var cell: Actor = cells.get(cell_id)
+	if cell == null:
+		var color := Color.hex(player_msg.get_color())
+		cell = Actor.instatiate(actor_id, player_msg.get_name(), x, y, radius, velocity.length(), color, false)
+		cell.cell_id = cell_id
+		_world.add_child(cell)
+		cell.z_index = 1
+		cells[cell_id] = cell
+		
+		if actor_id == GameManager.client_id:
+			cell.area_entered.connect(_on_player_area_entered.bind(cell))
+	else:
+		var server_position := Vector2(x, y)
+		if cell.position.distance_squared_to(server_position) > 100:
+			cell.server_position = server_position
+	
+	cell.velocity = velocity
+	_set_actor_mass(cell, _rad_to_mass(radius))
+
 func _handle_spore_msg(sender_id: int, spore_msg: packets.SporeMessage) -> void:
 	var spore_id := spore_msg.get_id()
 	var x := spore_msg.get_x()
@@ -134,8 +197,8 @@ func _handle_spores_batch_msg(sender_id: int, spores_batch_msg: packets.SporesBa
 		_handle_spore_msg(sender_id, spore_msg)
 		
 func _handle_spore_consumed_msg(sender_id: int, spore_consumed_msg: packets.SporeConsumedMessage) -> void:
-	if sender_id in _players:
-		var actor: Actor = _players[sender_id]
+	var actor := _get_body(sender_id, spore_consumed_msg.get_cell_id())
+	if actor != null:
 		var actor_mass := _rad_to_mass(actor.radius)
 		
 		var spore_id := spore_consumed_msg.get_spore_id()
@@ -153,49 +216,134 @@ func _handle_disconnect_msg(sender_id: int, disconnect_msg: packets.DisconnectMe
 		_log.info("%s disconnected because %s" % [actor.actor_name, reason])
 		_remove_actor(actor)
 		
+func _handle_player_consumed_msg(sender_id: int, player_consumed_msg: packets.PlayerConsumedMessage) -> void:
+	var body := _get_body(player_consumed_msg.get_player_id(), player_consumed_msg.get_cell_id())
+	if body == null:
+		return
+	
+	var eater := _get_body(sender_id, player_consumed_msg.get_eater_cell_id())
+	if eater != null:
+		_set_actor_mass(eater, _rad_to_mass(eater.radius) + _rad_to_mass(body.radius))
+	_remove_body(body)
+
+func _handle_cell_removed_msg(sender_id: int, cell_removed_msg: packets.CellRemovedMessage) -> void:
+	# The cell merged back into the main body, or took its place, which the next update shows
+	var cell := _get_body(cell_removed_msg.get_player_id(), cell_removed_msg.get_cell_id())
+	if cell != null and cell.cell_id != 0:
+		_remove_cell(cell)
+
+func _handle_death_summary_msg(sender_id: int, death_summary_msg: packets.DeathSummaryMessage) -> void:
+	# Whatever we were eaten by, our body is gone, but the world carries on behind the death screen
+	if GameManager.client_id in _players:
+		_remove_actor(_players[GameManager.client_id])
+	
+	var killer_name := death_summary_msg.get_killer_name()
+	var summary := "You were eaten"
+	if killer_name:
+		summary = "You were eaten by %s" % killer_name
+	summary += "\n\nPeak mass: %d\nTime alive: %.1fs\nSpores eaten: %d" % [
+		death_summary_msg.get_peak_mass(),
+		death_summary_msg.get_time_alive_ms() / 1000.0,
+		death_summary_msg.get_spores_eaten(),
+	]
+	_death_summary.text = summary
+	_death_screen.show()
+
+func _on_respawn_button_pressed() -> void:
+	var packet := packets.Packet.new()
+	packet.new_respawn_request()
+	WS.send(packet)
+
+func _handle_world_msg(sender_id: int, world_msg: packets.WorldMessage) -> void:
+	var size := world_msg.get_size()
+	var map_name := world_msg.get_map_name()
+	if map_name:
+		_log.info("Playing on %s" % map_name)
+	
+	if _world_layout != null:
+		_world_layout.queue_free()
+	_world_layout = Node2D.new()
+	_world.add_child(_world_layout)
+	
+	# The world is centered on the origin and extends size units in each direction
+	var border := Line2D.new()
+	border.width = 4
+	border.default_color = Color.DIM_GRAY
+	border.closed = true
+	if world_msg.get_shape() == packets.WorldShape.WORLD_CIRCLE:
+		for i in 64:
+			border.add_point(size * Vector2.from_angle(TAU * i / 64))
+	else:
+		border.points = PackedVector2Array([Vector2(-size, -size), Vector2(size, -size), Vector2(size, size), Vector2(-size, size)])
+	_world_layout.add_child(border)
+	
+	for region_msg in world_msg.get_obstacles():
+		var obstacle := ColorRect.new()
+		obstacle.position = Vector2(region_msg.get_x(), region_msg.get_y())
+		obstacle.size = Vector2(region_msg.get_width(), region_msg.get_height())
+		obstacle.color = Color.DIM_GRAY
+		_world_layout.add_child(obstacle)
+		
 func _rad_to_mass(radius: float) -> float:
 	return radius * radius * PI
 
 func _set_actor_mass(actor: Actor, new_mass: float) -> void:
 	actor.radius = sqrt(new_mass / PI)
-	_hiscores.set_hiscore(actor.actor_name, roundi(new_mass))
+	_hiscores.set_hiscore(actor.actor_name, roundi(_total_mass(actor.actor_id)))
 
-func _on_player_area_entered(area: Area2D) -> void:
+# Players are ranked by the mass of their main body and split cells together
+func _total_mass(actor_id: int) -> float:
+	var mass := 0.0
+	if actor_id in _players:
+		var actor: Actor = _players[actor_id]
+		mass += _rad_to_mass(actor.radius)
+	for cell: Actor in _cells.get(actor_id, {}).values():
+		mass += _rad_to_mass(cell.radius)
+	return mass
+
+# The main body of the player if the cell ID is 0, otherwise one of their split cells, or null
+func _get_body(actor_id: int, cell_id: int) -> Actor:
+	if cell_id == 0:
+		return _players.get(actor_id)
+	return _cells.get(actor_id, {}).get(cell_id)
+
+func _on_player_area_entered(area: Area2D, body: Actor) -> void:
 	if area is Spore:
-		_consume_spore(area as Spore)
-	elif area is Actor:
-		_collide_actor(area as Actor)
+		_consume_spore(area as Spore, body)
+	elif area is Actor and (area as Actor).actor_id != GameManager.client_id:
+		_collide_actor(area as Actor, body)
 		
-func _consume_spore(spore: Spore) -> void:
+func _consume_spore(spore: Spore, body: Actor) -> void:
 	if spore.underneath_player:
 		return
 	
 	var packet := packets.Packet.new()
 	var spore_consumed_msg := packet.new_spore_consumed()
 	spore_consumed_msg.set_spore_id(spore.spore_id)
+	spore_consumed_msg.set_cell_id(body.cell_id)
 	WS.send(packet)
 	_remove_spore(spore)
 	
-func _collide_actor(actor: Actor) -> void:
-	var player: Actor = _players[GameManager.client_id]
-	var player_mass := _rad_to_mass(player.radius)
+func _collide_actor(actor: Actor, body: Actor) -> void:
+	var body_mass := _rad_to_mass(body.radius)
 	var actor_mass := _rad_to_mass(actor.radius)
 	
-	if player_mass > actor_mass * 1.5:
-		_consume_actor(actor)
+	if body_mass > actor_mass * 1.5:
+		_consume_actor(actor, body)
 	
-func _consume_actor(actor: Actor) -> void:
-	var player: Actor = _players[GameManager.client_id]
-	var player_mass := _rad_to_mass(player.radius)
+func _consume_actor(actor: Actor, body: Actor) -> void:
+	var body_mass := _rad_to_mass(body.radius)
 	var actor_mass := _rad_to_mass(actor.radius)
-	_set_actor_mass(player, player_mass + actor_mass)
+	_set_actor_mass(body, body_mass + actor_mass)
 	
 	var packet := packets.Packet.new()
 	var player_consumed_msg := packet.new_player_consumed()
 	player_consumed_msg.set_player_id(actor.actor_id)
+	player_consumed_msg.set_cell_id(actor.cell_id)
+	player_consumed_msg.set_eater_cell_id(body.cell_id)
 	WS.send(packet)
 	
-	_remove_actor(actor)
+	_remove_body(actor)
 
 func _remove_spore(spore: Spore) -> void:
 	_spores.erase(spore.spore_id)
@@ -204,4 +352,21 @@ func _remove_spore(spore: Spore) -> void:
 func _remove_actor(actor: Actor) -> void:
 	_players.erase(actor.actor_id)
 	actor.queue_free()
+	for cell: Actor in _cells.get(actor.actor_id, {}).values():
+		cell.queue_free()
+	_cells.erase(actor.actor_id)
 	_hiscores.remove_hiscore(actor.actor_name)
+
+func _remove_cell(cell: Actor) -> void:
+	var cells: Dictionary = _cells.get(cell.actor_id, {})
+	cells.erase(cell.cell_id)
+	cell.queue_free()
+	_hiscores.set_hiscore(cell.actor_name, roundi(_total_mass(cell.actor_id)))
+
+# Remove an eaten body. A main body with split cells left is replaced by one of them, which the
+# server tells us about, so only a player's last body takes them out of the game.
+func _remove_body(body: Actor) -> void:
+	if body.cell_id != 0:
+		_remove_cell(body)
+	elif _cells.get(body.actor_id, {}).is_empty():
+		_remove_actor(body)
@@ -27,6 +27,9 @@ type config struct {
 	CertPath   string
 	KeyPath    string
 	ClientPath string
+
+	// Where to serve the world mass metrics, kept off the public port, or empty not to serve them
+	MetricsAddr string
 }
 
 var (
@@ -40,6 +43,7 @@ func loadConfig() *config {
 	cfg.CertPath = os.Getenv("CERT_PATH")
 	cfg.KeyPath = os.Getenv("KEY_PATH")
 	cfg.ClientPath = os.Getenv("CLIENT_PATH")
+	cfg.MetricsAddr = os.Getenv("METRICS_ADDR")
 
 	port, err := strconv.Atoi(os.Getenv("PORT"))
 	if err != nil {
@@ -117,6 +121,19 @@ func main() {
 		hub.Serve(clients.NewWebSocketClient, w, r)
 	})
 
+	// Serve the world mass metrics of the running arenas to operators on their own listener, such
+	// as a port only reachable from the host
+	if cfg.MetricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.HandleFunc("/metrics", hub.ServeMetrics)
+		go func() {
+			log.Printf("Serving metrics on %s", cfg.MetricsAddr)
+			if err := http.ListenAndServe(cfg.MetricsAddr, metricsMux); err != nil {
+				log.Printf("Failed to serve metrics: %v", err)
+			}
+		}()
+	}
+
 	go hub.Run()
 	addr := fmt.Sprintf(":%d", cfg.Port)
 
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+// The kinds of milestones announced to everyone in an arena
+const (
+	AnnouncementKillStreak    = "kill_streak"
+	AnnouncementNewLeader     = "new_leader"
+	AnnouncementMassMilestone = "mass_milestone"
+	AnnouncementBounty        = "bounty"
+	AnnouncementBountyClaimed = "bounty_claimed"
+)
+
+// At most this many announcements go out in any window, so big battles don't flood the clients
+const (
+	announcementBurst  = 3
+	announcementWindow = 5 * time.Second
+)
+
+// Keeps track of recent announcements to rate limit them
+type announcer struct {
+	mux    sync.Mutex
+	recent []time.Time
+}
+
+// Reports whether another announcement can go out now, counting it if so
+func (an *announcer) allow() bool {
+	an.mux.Lock()
+	defer an.mux.Unlock()
+
+	now := time.Now()
+	for len(an.recent) > 0 && now.Sub(an.recent[0]) >= announcementWindow {
+		an.recent = an.recent[1:]
+	}
+	if len(an.recent) >= announcementBurst {
+		return false
+	}
+
+	an.recent = append(an.recent, now)
+	return true
+}
+
+// Announce tells everyone in the arena about a milestone reached by the given player, unless too
+// many announcements have gone out recently. Reports whether it was sent.
+func (a *Arena) Announce(kind string, playerId uint64, text string) bool {
+	if !a.announcer.allow() {
+		a.logger.Printf("Dropping %s announcement, too many recently", kind)
+		return false
+	}
+
+	a.Broadcast(&packets.Packet{SenderId: 0, Msg: packets.NewAnnouncement(kind, playerId, text)})
+	return true
+}
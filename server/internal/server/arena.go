@@ -0,0 +1,802 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultMaxPlayers  = 50
+	DefaultMaxSpores   = 1000
+	DefaultWorldSize   = 3000.0
+	DefaultMaxHazards  = 20
+	DefaultMaxPowerUps = 10
+	DefaultLobbyTime   = 15 * time.Second
+
+	// How long spores dropped or ejected by players last by default
+	DefaultSporeLifetime = 30 * time.Second
+
+	// The size power-ups spawn with
+	powerUpRadius = 15.0
+
+	// The size hazards spawn with
+	HazardRadius = 60.0
+
+	// How many ejected spores a hazard absorbs before it shoots off a new hazard
+	hazardFeedLimit = 7
+
+	// The speed a hazard shot off by another one is launched with
+	hazardLaunchSpeed = 700.0
+
+	// Launched objects slower than this have come to rest
+	minLaunchSpeed = 5.0
+
+	// Prey food flees from players that come within this distance, at this speed
+	preyFleeRadius = 300.0
+	preySpeed      = 100.0
+)
+
+// How a player's speed falls off as they gain mass
+type SpeedCurve struct {
+	// The speed of players at or below the reference mass
+	BaseSpeed     float64 `json:"base_speed"`
+	ReferenceMass float64 `json:"reference_mass"`
+
+	// How steeply speed falls off above the reference mass
+	Exponent float64 `json:"exponent"`
+
+	// No player is ever slower than this, however massive
+	MinSpeed float64 `json:"min_speed"`
+}
+
+// The speed curve arenas use unless configured otherwise
+var DefaultSpeedCurve = SpeedCurve{
+	BaseSpeed:     150.0,
+	ReferenceMass: math.Pi * 20 * 20,
+	Exponent:      0.22,
+	MinSpeed:      50.0,
+}
+
+// Speed returns the speed of a player with the given mass
+func (c SpeedCurve) Speed(mass float64) float64 {
+	if mass <= c.ReferenceMass {
+		return c.BaseSpeed
+	}
+	return max(c.BaseSpeed*math.Pow(mass/c.ReferenceMass, -c.Exponent), c.MinSpeed)
+}
+
+func (c SpeedCurve) validate() error {
+	if c.BaseSpeed <= 0 || c.ReferenceMass <= 0 {
+		return errors.New("speed curve needs a positive base speed and reference mass")
+	}
+	if c.Exponent < 0 {
+		return errors.New("speed curve can't speed players up as they gain mass")
+	}
+	if c.MinSpeed <= 0 || c.MinSpeed > c.BaseSpeed {
+		return errors.New("speed curve minimum speed must be positive and at most the base speed")
+	}
+	return nil
+}
+
+// How large players lose mass over time, so nobody stays on top forever
+type MassDecay struct {
+	// Cells only decay while they are above this mass
+	Threshold float64 `json:"threshold"`
+
+	// The share of a cell's mass above the threshold it loses per second
+	Rate float64 `json:"rate"`
+
+	// Whether the decayed mass is returned to the world as spores near the player
+	ReturnAsSpores bool `json:"return_as_spores"`
+}
+
+// The mass decay arenas use unless configured otherwise
+var DefaultMassDecay = MassDecay{
+	Threshold:      math.Pi * 80 * 80,
+	Rate:           0.02,
+	ReturnAsSpores: true,
+}
+
+// Loss returns how much mass a cell with the given mass decays by over delta seconds
+func (d MassDecay) Loss(mass float64, delta float64) float64 {
+	if mass <= d.Threshold {
+		return 0
+	}
+	return (mass - d.Threshold) * d.Rate * delta
+}
+
+func (d MassDecay) validate() error {
+	if d.Threshold < 0 {
+		return errors.New("mass decay threshold can't be negative")
+	}
+	if d.Rate < 0 || d.Rate >= 1 {
+		return errors.New("mass decay rate must be at least 0 and less than 1")
+	}
+	return nil
+}
+
+// The settings a host can choose when creating a private arena. Zero values mean the default.
+type ArenaSettings struct {
+	// The name of the map to lay the arena out with, whose bounds take the place of the world size and shape
+	MapName string
+
+	WorldSize      float64
+	WorldShape     objects.WorldShape
+	MaxSpores      int
+	MaxPlayers     int
+	RecordHiscores bool
+
+	// The name of the game mode to play, which overrides the map's
+	Mode string
+}
+
+// An independent world hosted by the hub, with its own players, spores and broadcast scope
+type Arena struct {
+	Id          uint64
+	MaxPlayers  int
+	MaxSpores   int
+	MaxHazards  int
+	MaxPowerUps int
+
+	// The bounds, shape and layout of the world everything in the arena is confined to
+	World *objects.World
+
+	// The name of the map the world is laid out with, if any
+	MapName string
+
+	// Private arenas can only be joined with their invite code, and are never auto-assigned
+	Private    bool
+	InviteCode string
+
+	// The client hosting a private arena, who is allowed to kick other players, and the account
+	// they're playing as, to limit how many arenas each player hosts. The accounts of the players in
+	// or watching a private arena by client ID, so the host can kick them whatever they're doing,
+	// and the accounts the host has kicked, who can't come back.
+	membersMux   sync.Mutex
+	hostId       uint64
+	hostPlayerId int64
+	members      map[uint64]int64
+	kicked       map[int64]bool
+
+	// Whether scores reached in this arena count toward the global hiscores
+	RecordHiscores bool
+
+	// How fast players move depending on their mass
+	SpeedCurve SpeedCurve
+
+	// How large players lose mass over time
+	MassDecay MassDecay
+
+	// The kinds of power-ups spawned in this arena, and what they do
+	PowerUps []PowerUpEffect
+
+	// The mix of food spawned in this arena, and what each kind is worth
+	FoodTypes []FoodType
+
+	// The rules the arena is played by
+	Mode GameMode
+
+	// How long players wait in the lobby before each round, in modes that have one
+	LobbyTime time.Duration
+
+	// How long each round lasts in modes with rounds of a set length, or 0 to leave it to the mode
+	RoundTime time.Duration
+
+	// How long spores dropped or ejected by players last before they're cleared away
+	SporeLifetime time.Duration
+
+	// Clients currently playing in this arena
+	Clients *objects.SharedCollection[ClientInterfacer]
+
+	// Clients watching this arena without playing, who don't count toward its capacity
+	Spectators *objects.SharedCollection[ClientInterfacer]
+
+	// Packets in this channel will be processed by all players and spectators in the arena except the sender
+	BroadcastChan chan *packets.Packet
+
+	SharedGameObjects *SharedGameObjects
+
+	// The state of the world's control zones
+	controlZones *controlZones
+
+	// Rate limits the milestones announced in the arena
+	announcer *announcer
+
+	// The player at the top of the leaderboard when it was last sent, and since when
+	leaderId    uint64
+	leaderSince time.Time
+
+	// How many dropped spores have been cleared away uneaten since the arena started
+	sporesExpired atomic.Int64
+
+	// For recording the results of the arena's matches
+	dbTx *DbTx
+
+	logger *log.Logger
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newArena() *Arena {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	a := &Arena{
+		MaxPlayers:     DefaultMaxPlayers,
+		MaxSpores:      DefaultMaxSpores,
+		MaxHazards:     DefaultMaxHazards,
+		MaxPowerUps:    DefaultMaxPowerUps,
+		World:          &objects.World{Shape: objects.WorldRect, Size: DefaultWorldSize},
+		RecordHiscores: true,
+		SpeedCurve:     DefaultSpeedCurve,
+		MassDecay:      DefaultMassDecay,
+		PowerUps:       DefaultPowerUps,
+		FoodTypes:      DefaultFoodTypes,
+		LobbyTime:      DefaultLobbyTime,
+		SporeLifetime:  DefaultSporeLifetime,
+		Clients:        objects.NewSharedCollection[ClientInterfacer](),
+		Spectators:     objects.NewSharedCollection[ClientInterfacer](),
+		BroadcastChan:  make(chan *packets.Packet),
+		SharedGameObjects: &SharedGameObjects{
+			Players:  objects.NewSharedCollection[*objects.Player](),
+			Spores:   objects.NewSharedCollection[*objects.Spore](),
+			Hazards:  objects.NewSharedCollection[*objects.Hazard](),
+			PowerUps: objects.NewSharedCollection[*objects.PowerUp](),
+		},
+		members:      make(map[uint64]int64),
+		kicked:       make(map[int64]bool),
+		controlZones: &controlZones{},
+		announcer:    &announcer{},
+		logger:       log.New(log.Writer(), "Arena unknown: ", log.LstdFlags),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+	a.Mode = &freeForAll{arena: a}
+	return a
+}
+
+// Lay the arena out with the given map
+func (a *Arena) applyMap(gameMap *GameMap) {
+	world := gameMap.World
+	a.World = &world
+	a.MapName = gameMap.Name
+	if len(gameMap.Food) > 0 {
+		a.FoodTypes = gameMap.Food
+	}
+	a.MaxHazards = gameMap.MaxHazards
+	a.LobbyTime = time.Duration(gameMap.LobbyTime * float64(time.Second))
+	a.RoundTime = time.Duration(gameMap.RoundTime * float64(time.Second))
+	if gameMap.Mode != "" {
+		a.Mode = gameModes[gameMap.Mode](a)
+	}
+	a.SpeedCurve = gameMap.SpeedCurve
+	a.MassDecay = gameMap.MassDecay
+}
+
+// Apply the host's settings to a newly created arena, rejecting any that are out of range
+func (a *Arena) applySettings(settings ArenaSettings, maps map[string]*GameMap) error {
+	if settings.MapName != "" {
+		gameMap, exists := maps[settings.MapName]
+		if !exists {
+			return fmt.Errorf("map %s does not exist", settings.MapName)
+		}
+		if settings.WorldSize != 0 || settings.WorldShape != objects.WorldRect {
+			return errors.New("the world size and shape are set by the map")
+		}
+		a.applyMap(gameMap)
+	}
+
+	if settings.WorldSize != 0 {
+		if settings.WorldSize < 500 || settings.WorldSize > 10000 {
+			return errors.New("world size must be between 500 and 10000")
+		}
+		a.World.Size = settings.WorldSize
+	}
+
+	switch settings.WorldShape {
+	case objects.WorldRect:
+	case objects.WorldCircle, objects.WorldTorus:
+		a.World.Shape = settings.WorldShape
+	default:
+		return errors.New("unknown world shape")
+	}
+
+	if settings.MaxSpores != 0 {
+		if settings.MaxSpores < 0 || settings.MaxSpores > 5000 {
+			return errors.New("spore count must be between 0 and 5000")
+		}
+		a.MaxSpores = settings.MaxSpores
+	}
+
+	if settings.MaxPlayers != 0 {
+		if settings.MaxPlayers < 0 || settings.MaxPlayers > DefaultMaxPlayers {
+			return fmt.Errorf("max players must be between 1 and %d", DefaultMaxPlayers)
+		}
+		a.MaxPlayers = settings.MaxPlayers
+	}
+
+	if settings.Mode != "" {
+		mode, err := newGameMode(settings.Mode, a)
+		if err != nil {
+			return err
+		}
+		a.Mode = mode
+	}
+
+	a.RecordHiscores = settings.RecordHiscores
+	return nil
+}
+
+// IsFull reports whether the arena has no room for another player
+func (a *Arena) IsFull() bool {
+	return a.Clients.Len() >= a.MaxPlayers
+}
+
+// IsEmpty reports whether nobody is playing in or watching the arena
+func (a *Arena) IsEmpty() bool {
+	return a.Clients.Len() <= 0 && a.Spectators.Len() <= 0
+}
+
+// Broadcast queues the packet to be processed by all clients in the arena except the sender.
+// Does nothing if the arena has been torn down.
+func (a *Arena) Broadcast(packet *packets.Packet) {
+	select {
+	case a.BroadcastChan <- packet:
+	case <-a.ctx.Done():
+	}
+}
+
+// HostId returns the ID of the client hosting the private arena, or 0 if nobody is
+func (a *Arena) HostId() uint64 {
+	a.membersMux.Lock()
+	defer a.membersMux.Unlock()
+
+	return a.hostId
+}
+
+// hostedBy reports whether the player with the given account is hosting the arena
+func (a *Arena) hostedBy(playerId int64) bool {
+	a.membersMux.Lock()
+	defer a.membersMux.Unlock()
+
+	return a.hostId != 0 && a.hostPlayerId == playerId
+}
+
+func (a *Arena) setHost(clientId uint64, playerId int64) {
+	a.membersMux.Lock()
+	defer a.membersMux.Unlock()
+
+	a.hostId = clientId
+	a.hostPlayerId = playerId
+}
+
+// Kick keeps the player with the given account out of the arena for good
+func (a *Arena) Kick(playerId int64) {
+	a.membersMux.Lock()
+	defer a.membersMux.Unlock()
+
+	a.kicked[playerId] = true
+}
+
+// IsKicked reports whether the player with the given account has been kicked from the arena
+func (a *Arena) IsKicked(playerId int64) bool {
+	a.membersMux.Lock()
+	defer a.membersMux.Unlock()
+
+	return a.kicked[playerId]
+}
+
+// AccountOf returns the account of the player the client with the given ID is playing as, or
+// watching as, in a private arena
+func (a *Arena) AccountOf(clientId uint64) (int64, bool) {
+	a.membersMux.Lock()
+	defer a.membersMux.Unlock()
+
+	playerId, exists := a.members[clientId]
+	return playerId, exists
+}
+
+func (a *Arena) addMember(clientId uint64, playerId int64) {
+	a.membersMux.Lock()
+	defer a.membersMux.Unlock()
+
+	a.members[clientId] = playerId
+}
+
+func (a *Arena) removeMember(clientId uint64) {
+	a.membersMux.Lock()
+	defer a.membersMux.Unlock()
+
+	delete(a.members, clientId)
+}
+
+// Fill the arena with its initial hazards and spores
+func (a *Arena) placeObjects() {
+	a.controlZones = newControlZones(a.World)
+
+	a.logger.Println("Placing hazards...")
+	for i := 0; i < a.MaxHazards; i++ {
+		a.SharedGameObjects.Hazards.Add(a.newHazard())
+	}
+
+	// Nobody has joined yet, so only the empty world's share of spores is placed
+	a.logger.Println("Placing spores...")
+	sporeMass, targetSporeMass := 0.0, a.targetSporeMass(0, 0)
+	for i := 0; i < a.MaxSpores && sporeMass < targetSporeMass; i++ {
+		spore := a.newSpore()
+		a.SharedGameObjects.Spores.Add(spore)
+		sporeMass += math.Pi * spore.Radius * spore.Radius
+	}
+
+	a.logger.Println("Placing power-ups...")
+	for i := 0; i < a.MaxPowerUps; i++ {
+		if powerUp := a.newPowerUp(); powerUp != nil {
+			a.SharedGameObjects.PowerUps.Add(powerUp)
+		}
+	}
+}
+
+func (a *Arena) Run() {
+	go a.replenishLoop(2 * time.Second)
+	go a.movingObjectsLoop(50 * time.Millisecond)
+	go a.economyLoop(100 * time.Millisecond)
+	go a.modeLoop(100 * time.Millisecond)
+	go a.leaderboardLoop(time.Second)
+	if len(a.World.ControlZones) > 0 {
+		go a.zonesLoop(500 * time.Millisecond)
+	}
+
+	for {
+		select {
+		case packet := <-a.BroadcastChan:
+			process := func(clientId uint64, client ClientInterfacer) {
+				if clientId != packet.SenderId {
+					client.ProcessMessage(packet.SenderId, packet.Msg)
+				}
+			}
+			a.Clients.ForEach(process)
+			a.Spectators.ForEach(process)
+		case <-a.ctx.Done():
+			a.logger.Println("Stopped")
+			return
+		}
+	}
+}
+
+// Stop the arena's loops. Any packets broadcast to the arena afterwards are dropped.
+func (a *Arena) stop() {
+	a.cancel()
+}
+
+func (a *Arena) newSpore() *objects.Spore {
+	sporeRadius := max(10+rand.NormFloat64()*3, 5)
+	x, y := objects.SpawnCoords(sporeRadius, a.World, a.World.SporeRegions, a.SharedGameObjects.Players, a.SharedGameObjects.Spores, a.SharedGameObjects.Hazards)
+	food, _ := pickWeighted(a.FoodTypes, func(f FoodType) float64 { return f.Weight })
+	return &objects.Spore{X: x, Y: y, Radius: sporeRadius, Kind: food.Kind}
+}
+
+// FoodValue returns how much eating a spore of the given kind is worth compared to a normal one
+func (a *Arena) FoodValue(kind string) float64 {
+	for _, food := range a.FoodTypes {
+		if food.Kind == kind {
+			return food.Value
+		}
+	}
+	return 1
+}
+
+func (a *Arena) newHazard() *objects.Hazard {
+	x, y := objects.SpawnCoords(HazardRadius, a.World, nil, a.SharedGameObjects.Players, nil, a.SharedGameObjects.Hazards)
+	return &objects.Hazard{X: x, Y: y, Radius: HazardRadius}
+}
+
+// Returns a power-up of a random kind from the arena's catalog, or nil if the catalog is empty
+func (a *Arena) newPowerUp() *objects.PowerUp {
+	effect, ok := pickWeighted(a.PowerUps, func(e PowerUpEffect) float64 { return e.Weight })
+	if !ok {
+		return nil
+	}
+
+	x, y := objects.SpawnCoords(powerUpRadius, a.World, a.World.SporeRegions, a.SharedGameObjects.Players, nil, a.SharedGameObjects.Hazards)
+	return &objects.PowerUp{X: x, Y: y, Radius: powerUpRadius, Kind: effect.Kind}
+}
+
+// PowerUpEffect returns what power-ups of the given kind do in this arena
+func (a *Arena) PowerUpEffect(kind string) (PowerUpEffect, bool) {
+	for _, effect := range a.PowerUps {
+		if effect.Kind == kind {
+			return effect, true
+		}
+	}
+	return PowerUpEffect{}, false
+}
+
+// Advance the game mode, announcing the winner whenever a round ends
+func (a *Arena) modeLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	lastTick := time.Now()
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			delta := now.Sub(lastTick).Seconds()
+			lastTick = now
+
+			a.Mode.Tick(delta)
+			if winner, over := a.Mode.EndOfRound(); over {
+				a.logger.Printf("Round over, won by %q", winner)
+				a.Broadcast(&packets.Packet{SenderId: 0, Msg: packets.NewRoundOver(winner, a.Mode.Standings())})
+			}
+		case <-a.ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *Arena) replenishLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-a.ctx.Done():
+			return
+		}
+
+		a.replenishHazards()
+		a.replenishPowerUps()
+	}
+}
+
+// Hazards are few and far between, so only one is put back at a time
+func (a *Arena) replenishHazards() {
+	if a.SharedGameObjects.Hazards.Len() >= a.MaxHazards {
+		return
+	}
+
+	hazard := a.newHazard()
+	hazardId := a.SharedGameObjects.Hazards.Add(hazard)
+
+	a.Broadcast(&packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewHazard(hazardId, hazard),
+	})
+}
+
+func (a *Arena) replenishPowerUps() {
+	if a.SharedGameObjects.PowerUps.Len() >= a.MaxPowerUps {
+		return
+	}
+
+	powerUp := a.newPowerUp()
+	if powerUp == nil {
+		return
+	}
+	powerUpId := a.SharedGameObjects.PowerUps.Add(powerUp)
+
+	a.Broadcast(&packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewPowerUp(powerUpId, powerUp),
+	})
+}
+
+// Move spores and hazards that have been launched until they come to rest, and prey away from
+// players. Spores that run into a hazard along the way are absorbed by it.
+func (a *Arena) movingObjectsLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	delta := rate.Seconds()
+	decay := math.Exp(-objects.LaunchDecayRate * delta)
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-a.ctx.Done():
+			return
+		}
+
+		a.SharedGameObjects.Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+			if spore.LaunchX == 0 && spore.LaunchY == 0 {
+				return
+			}
+
+			// Don't resurrect a spore that was consumed mid-flight
+			if _, exists := a.SharedGameObjects.Spores.Get(sporeId); !exists {
+				return
+			}
+
+			// Players may be looking at the spore, so move a copy of it instead
+			moved := *spore
+			moved.X += moved.LaunchX * delta
+			moved.Y += moved.LaunchY * delta
+			moved.X, moved.Y = a.World.Confine(moved.X, moved.Y, moved.Radius)
+
+			if a.feedHazard(sporeId, &moved) {
+				return
+			}
+
+			moved.LaunchX *= decay
+			moved.LaunchY *= decay
+			if math.Hypot(moved.LaunchX, moved.LaunchY) < minLaunchSpeed {
+				moved.LaunchX, moved.LaunchY = 0, 0
+			}
+
+			if !a.SharedGameObjects.Spores.Replace(sporeId, &moved) {
+				return
+			}
+
+			a.Broadcast(&packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewSpore(sporeId, &moved),
+			})
+		})
+
+		a.SharedGameObjects.Hazards.ForEach(func(hazardId uint64, hazard *objects.Hazard) {
+			if hazard.LaunchX == 0 && hazard.LaunchY == 0 {
+				return
+			}
+
+			moved := *hazard
+			moved.X += moved.LaunchX * delta
+			moved.Y += moved.LaunchY * delta
+			moved.X, moved.Y = a.World.Confine(moved.X, moved.Y, moved.Radius)
+			moved.LaunchX *= decay
+			moved.LaunchY *= decay
+			if math.Hypot(moved.LaunchX, moved.LaunchY) < minLaunchSpeed {
+				moved.LaunchX, moved.LaunchY = 0, 0
+			}
+
+			// A player may have run into the hazard in the meantime
+			if !a.SharedGameObjects.Hazards.Replace(hazardId, &moved) {
+				return
+			}
+
+			a.Broadcast(&packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewHazard(hazardId, &moved),
+			})
+		})
+
+		a.movePrey(delta)
+	}
+}
+
+// Move every prey spore away from the closest player within fleeing distance
+func (a *Arena) movePrey(delta float64) {
+	a.SharedGameObjects.Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		if spore.Kind != objects.SporePrey {
+			return
+		}
+
+		var awayX, awayY float64
+		closest := preyFleeRadius
+		a.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+			playerX, playerY, _ := player.Position()
+			dx, dy := a.World.Delta(playerX, playerY, spore.X, spore.Y)
+			if dist := math.Hypot(dx, dy); dist < closest && dist > 0 {
+				closest = dist
+				awayX, awayY = dx/dist, dy/dist
+			}
+		})
+
+		if awayX == 0 && awayY == 0 {
+			return
+		}
+
+		// Players may be looking at the spore, so move a copy of it instead. Replacing it doesn't
+		// resurrect a spore that was consumed in the meantime.
+		moved := *spore
+		moved.X, moved.Y = a.World.Confine(spore.X+awayX*preySpeed*delta, spore.Y+awayY*preySpeed*delta, spore.Radius)
+		if !a.SharedGameObjects.Spores.Replace(sporeId, &moved) {
+			return
+		}
+
+		a.Broadcast(&packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewSpore(sporeId, &moved),
+		})
+	})
+}
+
+// If the launched spore has run into a hazard, the hazard absorbs it and grows. Once a hazard has
+// absorbed enough, it shrinks back down and shoots off a new hazard in the direction the spore was
+// travelling. Returns whether the spore was absorbed.
+func (a *Arena) feedHazard(sporeId uint64, spore *objects.Spore) bool {
+	var fedId uint64
+	var fed *objects.Hazard
+	a.SharedGameObjects.Hazards.ForEach(func(hazardId uint64, hazard *objects.Hazard) {
+		if fed == nil && a.World.DistSq(hazard.X, hazard.Y, spore.X, spore.Y) <= (hazard.Radius+spore.Radius)*(hazard.Radius+spore.Radius) {
+			fedId, fed = hazardId, hazard
+		}
+	})
+
+	if fed == nil {
+		return false
+	}
+
+	// A player may have eaten the spore in the meantime, in which case its mass is theirs
+	if !a.SharedGameObjects.Spores.Remove(sporeId) {
+		return false
+	}
+	a.Broadcast(&packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewSporeConsumed(sporeId),
+	})
+
+	// Players may be looking at the hazard, so grow a copy of it instead
+	grown := *fed
+	var shot *objects.Hazard
+	grown.Fed++
+	if grown.Fed < hazardFeedLimit {
+		grown.Radius = math.Sqrt(grown.Radius*grown.Radius + spore.Radius*spore.Radius)
+	} else {
+		grown.Fed = 0
+		grown.Radius = HazardRadius
+
+		speed := math.Hypot(spore.LaunchX, spore.LaunchY)
+		dirX, dirY := 1.0, 0.0
+		if speed > 0 {
+			dirX, dirY = spore.LaunchX/speed, spore.LaunchY/speed
+		}
+
+		shot = &objects.Hazard{
+			Radius:  HazardRadius,
+			LaunchX: dirX * hazardLaunchSpeed,
+			LaunchY: dirY * hazardLaunchSpeed,
+		}
+		shot.X, shot.Y = a.World.Confine(grown.X+dirX*2*HazardRadius, grown.Y+dirY*2*HazardRadius, HazardRadius)
+	}
+
+	// A player may have run into the hazard in the meantime, taking the spore with it
+	if !a.SharedGameObjects.Hazards.Replace(fedId, &grown) {
+		return true
+	}
+
+	if shot != nil {
+		shotId := a.SharedGameObjects.Hazards.Add(shot)
+		a.Broadcast(&packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewHazard(shotId, shot),
+		})
+	}
+
+	a.Broadcast(&packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewHazard(fedId, &grown),
+	})
+
+	return true
+}
+
+// Pick one of the items at random according to their weights. Returns false if there is nothing
+// to pick from.
+func pickWeighted[T any](items []T, weight func(T) float64) (T, bool) {
+	totalWeight := 0.0
+	for _, item := range items {
+		totalWeight += weight(item)
+	}
+
+	pick := rand.Float64() * totalWeight
+	for _, item := range items {
+		if w := weight(item); w > 0 {
+			if pick -= w; pick <= 0 {
+				return item, true
+			}
+		}
+	}
+
+	var none T
+	return none, false
+}
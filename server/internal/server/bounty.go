@@ -0,0 +1,68 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+const (
+	// Holding the lead for this long, or killing this many players in a row, puts a bounty on a player
+	bountyLeadTime   = time.Minute
+	BountyKillStreak = 5
+
+	// The mass a bounty starts out worth, and how much it grows by every second it goes unclaimed
+	bountyBaseValue  = 500.0
+	bountyGrowthRate = 25.0
+)
+
+// PlaceBounty puts a bounty on the player for the given reason, unless they already have one
+func (a *Arena) PlaceBounty(playerId uint64, player *objects.Player, reason string) {
+	if !player.PlaceBounty(bountyBaseValue) {
+		return
+	}
+
+	a.logger.Printf("Placed a bounty on %s for %s", player.Name, reason)
+	a.announceBounty(AnnouncementBounty, playerId, fmt.Sprintf("A bounty has been placed on %s for %s", player.Name, reason))
+}
+
+// Put a bounty on whoever has been in the lead for too long, tracking how long the current leader
+// has been in front
+func (a *Arena) checkLeaderBounty(leaderId uint64, leader *objects.Player) {
+	if leaderId != a.leaderId {
+		a.leaderSince = time.Now()
+		return
+	}
+	if time.Since(a.leaderSince) >= bountyLeadTime {
+		a.PlaceBounty(leaderId, leader, "holding the lead")
+	}
+}
+
+// Bounties are worth more the longer they go unclaimed
+func (a *Arena) escalateBounties(delta float64) {
+	a.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		player.RaiseBounty(bountyGrowthRate * delta)
+	})
+}
+
+// ClaimBounty reports the mass the killer is owed for consuming the victim, announcing the claim if
+// the victim had a bounty on them. The bounty is taken off the victim, so it can't be claimed twice.
+func (a *Arena) ClaimBounty(killerId uint64, killer *objects.Player, victim *objects.Player) float64 {
+	bounty := victim.TakeBounty()
+	if bounty <= 0 {
+		return 0
+	}
+
+	reward := math.Round(bounty)
+	a.announceBounty(AnnouncementBountyClaimed, killerId, fmt.Sprintf("%s claimed the %d mass bounty on %s", killer.Name, int(reward), victim.Name))
+	return reward
+}
+
+// Each player can only have one bounty on them at a time, so these are rare enough to skip the
+// announcement rate limit. Players place and claim bounties while they're busy being updated, so
+// the announcement goes out without waiting for the arena.
+func (a *Arena) announceBounty(kind string, playerId uint64, text string) {
+	go a.Broadcast(&packets.Packet{SenderId: 0, Msg: packets.NewAnnouncement(kind, playerId, text)})
+}
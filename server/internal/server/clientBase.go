@@ -0,0 +1,204 @@
+package server
+
+import (
+	"log"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"sync/atomic"
+)
+
+// The bookkeeping every kind of client shares: its state machine, the arena it's in, and how it
+// reaches the hub and other clients. Clients embed it and only add their transport, i.e. how
+// packets get to and from the other end.
+type ClientBase struct {
+	id     uint64
+	hub    *Hub
+	logger *log.Logger
+	dbTx   *DbTx
+
+	// The client embedding this, which is who the hub and the states deal with
+	client ClientInterfacer
+
+	// The current state handler, which is read from the arena's goroutine as well as the client's own
+	state    ClientStateHandler
+	stateMux sync.Mutex
+
+	// The arena the client is in, which is read from the arena's goroutine as well as its own
+	arena atomic.Pointer[Arena]
+}
+
+// NewClientBase sets up the bookkeeping for the given client of the hub
+func NewClientBase(client ClientInterfacer, hub *Hub, logger *log.Logger) *ClientBase {
+	return &ClientBase{
+		hub:    hub,
+		logger: logger,
+		dbTx:   hub.NewDbTx(),
+		client: client,
+	}
+}
+
+func (b *ClientBase) Id() uint64 {
+	return b.id
+}
+
+// SetId gives the client the ID the hub registered it with, and logs under the given prefix from now on
+func (b *ClientBase) SetId(id uint64, logPrefix string) {
+	b.id = id
+	b.logger.SetPrefix(logPrefix)
+}
+
+// Logger returns the logger the client logs to
+func (b *ClientBase) Logger() *log.Logger {
+	return b.logger
+}
+
+func (b *ClientBase) SetState(state ClientStateHandler) {
+	prevStateName := "None"
+	prevState := b.State()
+	if prevState != nil {
+		prevStateName = prevState.Name()
+		prevState.OnExit()
+	}
+
+	newStateName := "None"
+	if state != nil {
+		newStateName = state.Name()
+	}
+
+	b.logger.Printf("Switching from state %s to %s", prevStateName, newStateName)
+
+	// Set the new state up before the arena's goroutine can hand it messages
+	if state != nil {
+		state.SetClient(b.client)
+	}
+
+	b.stateMux.Lock()
+	b.state = state
+	b.stateMux.Unlock()
+
+	if state != nil {
+		state.OnEnter()
+	}
+}
+
+// State returns the current state handler of the client
+func (b *ClientBase) State() ClientStateHandler {
+	b.stateMux.Lock()
+	defer b.stateMux.Unlock()
+	return b.state
+}
+
+func (b *ClientBase) ProcessMessage(senderId uint64, message packets.Msg) {
+	if state := b.State(); state != nil {
+		state.HandleMessage(senderId, message)
+	}
+}
+
+func (b *ClientBase) SocketSend(message packets.Msg) {
+	b.client.SocketSendAs(message, b.id)
+}
+
+func (b *ClientBase) PassToPeer(message packets.Msg, peerId uint64) {
+	if peer, exists := b.hub.Clients.Get(peerId); exists {
+		peer.ProcessMessage(b.id, message)
+	}
+}
+
+func (b *ClientBase) Broadcast(message packets.Msg) {
+	packet := &packets.Packet{SenderId: b.id, Msg: message}
+	if arena := b.arena.Load(); arena != nil {
+		arena.Broadcast(packet)
+	} else {
+		b.hub.BroadcastChan <- packet
+	}
+}
+
+func (b *ClientBase) DbTx() *DbTx {
+	return b.dbTx
+}
+
+func (b *ClientBase) Arena() *Arena {
+	return b.arena.Load()
+}
+
+func (b *ClientBase) JoinArena(arenaId uint64) error {
+	b.LeaveArena()
+
+	arena, err := b.hub.JoinArena(b.client, arenaId)
+	if err != nil {
+		return err
+	}
+
+	b.arena.Store(arena)
+	b.logger.Printf("Joined arena %d", arena.Id)
+	return nil
+}
+
+func (b *ClientBase) JoinPrivateArena(inviteCode string, playerId int64) error {
+	b.LeaveArena()
+
+	arena, err := b.hub.JoinPrivateArena(b.client, inviteCode, playerId)
+	if err != nil {
+		return err
+	}
+
+	b.arena.Store(arena)
+	b.logger.Printf("Joined arena %d", arena.Id)
+	return nil
+}
+
+func (b *ClientBase) SpectateArena(arenaId uint64, inviteCode string, playerId int64) error {
+	arena, err := b.hub.SpectateArena(b.client, arenaId, inviteCode, playerId)
+	if err != nil {
+		return err
+	}
+
+	if b.arena.Load() != arena {
+		b.LeaveArena()
+	}
+
+	b.arena.Store(arena)
+	b.logger.Printf("Spectating arena %d", arena.Id)
+	return nil
+}
+
+func (b *ClientBase) CreatePrivateArena(playerId int64, settings ArenaSettings) (*Arena, error) {
+	return b.hub.CreatePrivateArena(b.id, playerId, settings)
+}
+
+func (b *ClientBase) LeaveArena() {
+	arena := b.arena.Load()
+	if arena == nil {
+		return
+	}
+
+	b.logger.Printf("Leaving arena %d", arena.Id)
+	b.hub.LeaveArena(b.client, arena)
+	b.arena.Store(nil)
+}
+
+func (b *ClientBase) Arenas() *objects.SharedCollection[*Arena] {
+	return b.hub.Arenas
+}
+
+func (b *ClientBase) SharedGameObjects() *SharedGameObjects {
+	arena := b.arena.Load()
+	if arena == nil {
+		return nil
+	}
+	return arena.SharedGameObjects
+}
+
+// Shutdown tells everyone the client is leaving, takes it out of its state and arena, and
+// unregisters it from the hub. The client closes its transport after.
+func (b *ClientBase) Shutdown(reason string) {
+	b.logger.Printf("Closing client connection because: %s", reason)
+
+	b.Broadcast(packets.NewDisconnect(reason))
+
+	b.SetState(nil)
+	b.LeaveArena()
+
+	b.hub.UnregisterChan <- b.client
+}
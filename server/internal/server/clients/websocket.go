@@ -13,13 +13,9 @@ import (
 )
 
 type WebSocketClient struct {
-	id       uint64
+	*server.ClientBase
 	conn     *websocket.Conn
-	hub      *server.Hub
 	sendChan chan *packets.Packet
-	state    server.ClientStateHandler
-	logger   *log.Logger
-	dbTx     *server.DbTx
 }
 
 func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *http.Request) (server.ClientInterfacer, error) {
@@ -36,77 +32,30 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 	}
 
 	c := &WebSocketClient{
-		hub:      hub,
 		conn:     conn,
 		sendChan: make(chan *packets.Packet, 256),
-		logger:   log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
-		dbTx:     hub.NewDbTx(),
 	}
+	c.ClientBase = server.NewClientBase(c, hub, log.New(log.Writer(), "Client unknown: ", log.LstdFlags))
 
 	return c, nil
 }
 
-func (c *WebSocketClient) Id() uint64 {
-	return c.id
-}
-
-func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
-	prevStateName := "None"
-	if c.state != nil {
-		prevStateName = c.state.Name()
-		c.state.OnExit()
-	}
-
-	newStateName := "None"
-	if state != nil {
-		newStateName = state.Name()
-	}
-
-	c.logger.Printf("Switching from state %s to %s", prevStateName, newStateName)
-
-	c.state = state
-
-	if c.state != nil {
-		c.state.SetClient(c)
-		c.state.OnEnter()
-	}
-}
-
-func (c *WebSocketClient) ProcessMessage(senderId uint64, message packets.Msg) {
-	c.state.HandleMessage(senderId, message)
-}
-
 func (c *WebSocketClient) Initialize(id uint64) {
-	c.id = id
-	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", c.id))
+	c.SetId(id, fmt.Sprintf("Client %d: ", id))
 	c.SetState(&states.Connected{})
 }
 
-func (c *WebSocketClient) SocketSend(message packets.Msg) {
-	c.SocketSendAs(message, c.id)
-}
-
 func (c *WebSocketClient) SocketSendAs(message packets.Msg, senderId uint64) {
 	select {
 	case c.sendChan <- &packets.Packet{SenderId: senderId, Msg: message}:
 	default:
-		c.logger.Printf("Send channel full, dropping message: %T", message)
+		c.Logger().Printf("Send channel full, dropping message: %T", message)
 	}
 }
 
-func (c *WebSocketClient) PassToPeer(message packets.Msg, peerId uint64) {
-	if peer, exists := c.hub.Clients.Get(peerId); exists {
-		peer.ProcessMessage(c.id, message)
-	}
-}
-
-func (c *WebSocketClient) Broadcast(message packets.Msg) {
-	c.hub.BroadcastChan <- &packets.Packet{SenderId: c.id, Msg: message}
-}
-
 func (c *WebSocketClient) ReadPump() {
 	defer func() {
-		c.logger.Println("Closing read pump")
+		c.Logger().Println("Closing read pump")
 		c.Close("read pump closed")
 	}()
 
@@ -114,7 +63,7 @@ func (c *WebSocketClient) ReadPump() {
 		_, data, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				c.logger.Printf("Error: %v", err)
+				c.Logger().Printf("Error: %v", err)
 			}
 			break
 		}
@@ -122,13 +71,13 @@ func (c *WebSocketClient) ReadPump() {
 		packet := &packets.Packet{}
 		err = proto.Unmarshal(data, packet)
 		if err != nil {
-			c.logger.Printf("error unmarshalling data: %v", err)
+			c.Logger().Printf("error unmarshalling data: %v", err)
 			continue
 		}
 
 		// To allow the client to lazily not send the sender ID, we'll assume they want to send it as themselves
 		if packet.SenderId == 0 {
-			packet.SenderId = c.id
+			packet.SenderId = c.Id()
 		}
 
 		c.ProcessMessage(packet.SenderId, packet.Msg)
@@ -137,54 +86,41 @@ func (c *WebSocketClient) ReadPump() {
 
 func (c *WebSocketClient) WritePump() {
 	defer func() {
-		c.logger.Println("Closing write pump")
+		c.Logger().Println("Closing write pump")
 		c.Close("write pump closed")
 	}()
 
 	for packet := range c.sendChan {
 		writer, err := c.conn.NextWriter(websocket.BinaryMessage)
 		if err != nil {
-			c.logger.Printf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
+			c.Logger().Printf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
 			return
 		}
 
 		data, err := proto.Marshal(packet)
 		if err != nil {
-			c.logger.Printf("error marshalling %T packet, closing client: %v", packet.Msg, err)
+			c.Logger().Printf("error marshalling %T packet, closing client: %v", packet.Msg, err)
 			continue
 		}
 
 		_, err = writer.Write(data)
 		if err != nil {
-			c.logger.Printf("error writing %T packet: %v", packet.Msg, err)
+			c.Logger().Printf("error writing %T packet: %v", packet.Msg, err)
 			continue
 		}
 
 		writer.Write([]byte{'\n'})
 
 		if err = writer.Close(); err != nil {
-			c.logger.Printf("error closing writer for %T packet: %v", packet.Msg, err)
+			c.Logger().Printf("error closing writer for %T packet: %v", packet.Msg, err)
 			continue
 		}
 	}
 }
 
-func (c *WebSocketClient) DbTx() *server.DbTx {
-	return c.dbTx
-}
-
-func (c *WebSocketClient) SharedGameObjects() *server.SharedGameObjects {
-	return c.hub.SharedGameObjects
-}
-
 func (c *WebSocketClient) Close(reason string) {
-	c.logger.Printf("Closing client connection because: %s", reason)
-
-	c.Broadcast(packets.NewDisconnect(reason))
-
-	c.SetState(nil)
+	c.Shutdown(reason)
 
-	c.hub.UnregisterChan <- c
 	c.conn.Close()
 	if _, closed := <-c.sendChan; !closed {
 		close(c.sendChan)
@@ -22,6 +22,10 @@ RETURNING *;
 SELECT * FROM players
 WHERE user_id = ? LIMIT 1;
 
+-- name: GetPlayerById :one
+SELECT * FROM players
+WHERE id = ? LIMIT 1;
+
 -- name: UpdatePlayerBestScore :exec
 UPDATE players
 SET best_score = ?
@@ -44,4 +48,78 @@ SELECT COUNT(*) + 1 as "rank" FROM players
 WHERE best_score >= (
     SELECT best_score FROM players p2
     WHERE p2.id = ?
-);
\ No newline at end of file
+);
+
+-- name: CreateMatch :one
+INSERT INTO matches (
+    mode, winner, started_at, ended_at
+) VALUES (
+    ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: CreateMatchPlayer :exec
+INSERT INTO match_players (
+    match_id, player_id, role, score
+) VALUES (
+    ?, ?, ?, ?
+);
+
+-- name: GetPlayerMatches :many
+SELECT matches.id, matches.mode, matches.winner, matches.started_at, matches.ended_at, match_players.role, match_players.score
+FROM match_players
+JOIN matches ON matches.id = match_players.match_id
+WHERE match_players.player_id = ?
+ORDER BY matches.ended_at DESC
+LIMIT ?;
+
+-- name: UpdatePlayerBestZoneScore :exec
+INSERT INTO zone_scores (
+    player_id, best_score
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE
+SET best_score = MAX(best_score, excluded.best_score);
+
+-- name: GetTopZoneScores :many
+SELECT players.name, zone_scores.best_score
+FROM zone_scores
+JOIN players ON players.id = zone_scores.player_id
+ORDER BY zone_scores.best_score DESC
+LIMIT ?
+OFFSET ?;
+
+-- name: GetPlayerZoneRank :one
+SELECT COUNT(*) + 1 as "rank" FROM zone_scores
+WHERE best_score >= (
+    SELECT best_score FROM zone_scores z2
+    WHERE z2.player_id = ?
+);
+
+-- name: RecordBountyClaim :exec
+INSERT INTO player_stats (
+    player_id, bounties_claimed, bounty_mass_claimed
+) VALUES (
+    ?, 1, ?
+)
+ON CONFLICT (player_id) DO UPDATE
+SET bounties_claimed = bounties_claimed + 1,
+    bounty_mass_claimed = bounty_mass_claimed + excluded.bounty_mass_claimed;
+
+-- name: GetPlayerStats :one
+SELECT * FROM player_stats
+WHERE player_id = ? LIMIT 1;
+
+-- name: UpdatePlayerBestCombo :exec
+INSERT INTO combo_records (
+    player_id, best_combo
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE
+SET best_combo = MAX(best_combo, excluded.best_combo);
+
+-- name: GetPlayerBestCombo :one
+SELECT best_combo FROM combo_records
+WHERE player_id = ? LIMIT 1;
@@ -11,4 +11,40 @@ CREATE TABLE IF NOT EXISTS players (
     best_score INTEGER NOT NULL DEFAULT 0,
     color INTEGER NOT NULL,
     FOREIGN KEY (user_id) REFERENCES users(id)
-);
\ No newline at end of file
+);
+
+CREATE TABLE IF NOT EXISTS matches (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    mode TEXT NOT NULL,
+    winner TEXT NOT NULL,
+    started_at INTEGER NOT NULL,
+    ended_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS match_players (
+    match_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    role TEXT NOT NULL,
+    score INTEGER NOT NULL,
+    FOREIGN KEY (match_id) REFERENCES matches(id),
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS zone_scores (
+    player_id INTEGER PRIMARY KEY,
+    best_score INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS player_stats (
+    player_id INTEGER PRIMARY KEY,
+    bounties_claimed INTEGER NOT NULL DEFAULT 0,
+    bounty_mass_claimed INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS combo_records (
+    player_id INTEGER PRIMARY KEY,
+    best_combo INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
@@ -4,6 +4,26 @@
 
 package db
 
+type ComboRecord struct {
+	PlayerID  int64
+	BestCombo int64
+}
+
+type Match struct {
+	ID        int64
+	Mode      string
+	Winner    string
+	StartedAt int64
+	EndedAt   int64
+}
+
+type MatchPlayer struct {
+	MatchID  int64
+	PlayerID int64
+	Role     string
+	Score    int64
+}
+
 type Player struct {
 	ID        int64
 	UserID    int64
@@ -12,8 +32,19 @@ type Player struct {
 	Color     int64
 }
 
+type PlayerStat struct {
+	PlayerID          int64
+	BountiesClaimed   int64
+	BountyMassClaimed int64
+}
+
 type User struct {
 	ID           int64
 	Username     string
 	PasswordHash string
 }
+
+type ZoneScore struct {
+	PlayerID  int64
+	BestScore int64
+}
@@ -9,6 +9,65 @@ import (
 	"context"
 )
 
+const createMatch = `-- name: CreateMatch :one
+INSERT INTO matches (
+    mode, winner, started_at, ended_at
+) VALUES (
+    ?, ?, ?, ?
+)
+RETURNING id, mode, winner, started_at, ended_at
+`
+
+type CreateMatchParams struct {
+	Mode      string
+	Winner    string
+	StartedAt int64
+	EndedAt   int64
+}
+
+func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (Match, error) {
+	row := q.db.QueryRowContext(ctx, createMatch,
+		arg.Mode,
+		arg.Winner,
+		arg.StartedAt,
+		arg.EndedAt,
+	)
+	var i Match
+	err := row.Scan(
+		&i.ID,
+		&i.Mode,
+		&i.Winner,
+		&i.StartedAt,
+		&i.EndedAt,
+	)
+	return i, err
+}
+
+const createMatchPlayer = `-- name: CreateMatchPlayer :exec
+INSERT INTO match_players (
+    match_id, player_id, role, score
+) VALUES (
+    ?, ?, ?, ?
+)
+`
+
+type CreateMatchPlayerParams struct {
+	MatchID  int64
+	PlayerID int64
+	Role     string
+	Score    int64
+}
+
+func (q *Queries) CreateMatchPlayer(ctx context.Context, arg CreateMatchPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, createMatchPlayer,
+		arg.MatchID,
+		arg.PlayerID,
+		arg.Role,
+		arg.Score,
+	)
+	return err
+}
+
 const createPlayer = `-- name: CreatePlayer :one
 INSERT INTO players (
     user_id, name, color
@@ -58,6 +117,36 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 	return i, err
 }
 
+const getPlayerBestCombo = `-- name: GetPlayerBestCombo :one
+SELECT best_combo FROM combo_records
+WHERE player_id = ? LIMIT 1
+`
+
+func (q *Queries) GetPlayerBestCombo(ctx context.Context, playerID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerBestCombo, playerID)
+	var best_combo int64
+	err := row.Scan(&best_combo)
+	return best_combo, err
+}
+
+const getPlayerById = `-- name: GetPlayerById :one
+SELECT id, user_id, name, best_score, color FROM players
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetPlayerById(ctx context.Context, id int64) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerById, id)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+	)
+	return i, err
+}
+
 const getPlayerByName = `-- name: GetPlayerByName :one
 SELECT id, user_id, name, best_score, color FROM players
 WHERE name LIKE ?
@@ -95,6 +184,61 @@ func (q *Queries) GetPlayerByUserId(ctx context.Context, userID int64) (Player,
 	return i, err
 }
 
+const getPlayerMatches = `-- name: GetPlayerMatches :many
+SELECT matches.id, matches.mode, matches.winner, matches.started_at, matches.ended_at, match_players.role, match_players.score
+FROM match_players
+JOIN matches ON matches.id = match_players.match_id
+WHERE match_players.player_id = ?
+ORDER BY matches.ended_at DESC
+LIMIT ?
+`
+
+type GetPlayerMatchesParams struct {
+	PlayerID int64
+	Limit    int64
+}
+
+type GetPlayerMatchesRow struct {
+	ID        int64
+	Mode      string
+	Winner    string
+	StartedAt int64
+	EndedAt   int64
+	Role      string
+	Score     int64
+}
+
+func (q *Queries) GetPlayerMatches(ctx context.Context, arg GetPlayerMatchesParams) ([]GetPlayerMatchesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerMatches, arg.PlayerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlayerMatchesRow
+	for rows.Next() {
+		var i GetPlayerMatchesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Mode,
+			&i.Winner,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Role,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPlayerRank = `-- name: GetPlayerRank :one
 SELECT COUNT(*) + 1 as "rank" FROM players
 WHERE best_score >= (
@@ -110,6 +254,33 @@ func (q *Queries) GetPlayerRank(ctx context.Context, id int64) (int64, error) {
 	return rank, err
 }
 
+const getPlayerStats = `-- name: GetPlayerStats :one
+SELECT player_id, bounties_claimed, bounty_mass_claimed FROM player_stats
+WHERE player_id = ? LIMIT 1
+`
+
+func (q *Queries) GetPlayerStats(ctx context.Context, playerID int64) (PlayerStat, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerStats, playerID)
+	var i PlayerStat
+	err := row.Scan(&i.PlayerID, &i.BountiesClaimed, &i.BountyMassClaimed)
+	return i, err
+}
+
+const getPlayerZoneRank = `-- name: GetPlayerZoneRank :one
+SELECT COUNT(*) + 1 as "rank" FROM zone_scores
+WHERE best_score >= (
+    SELECT best_score FROM zone_scores z2
+    WHERE z2.player_id = ?
+)
+`
+
+func (q *Queries) GetPlayerZoneRank(ctx context.Context, playerID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerZoneRank, playerID)
+	var rank int64
+	err := row.Scan(&rank)
+	return rank, err
+}
+
 const getTopScores = `-- name: GetTopScores :many
 SELECT name, best_score
 FROM players
@@ -151,6 +322,48 @@ func (q *Queries) GetTopScores(ctx context.Context, arg GetTopScoresParams) ([]G
 	return items, nil
 }
 
+const getTopZoneScores = `-- name: GetTopZoneScores :many
+SELECT players.name, zone_scores.best_score
+FROM zone_scores
+JOIN players ON players.id = zone_scores.player_id
+ORDER BY zone_scores.best_score DESC
+LIMIT ?
+OFFSET ?
+`
+
+type GetTopZoneScoresParams struct {
+	Limit  int64
+	Offset int64
+}
+
+type GetTopZoneScoresRow struct {
+	Name      string
+	BestScore int64
+}
+
+func (q *Queries) GetTopZoneScores(ctx context.Context, arg GetTopZoneScoresParams) ([]GetTopZoneScoresRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopZoneScores, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopZoneScoresRow
+	for rows.Next() {
+		var i GetTopZoneScoresRow
+		if err := rows.Scan(&i.Name, &i.BestScore); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserByUsername = `-- name: GetUserByUsername :one
 SELECT id, username, password_hash FROM users
 WHERE username = ? LIMIT 1
@@ -163,6 +376,47 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 	return i, err
 }
 
+const recordBountyClaim = `-- name: RecordBountyClaim :exec
+INSERT INTO player_stats (
+    player_id, bounties_claimed, bounty_mass_claimed
+) VALUES (
+    ?, 1, ?
+)
+ON CONFLICT (player_id) DO UPDATE
+SET bounties_claimed = bounties_claimed + 1,
+    bounty_mass_claimed = bounty_mass_claimed + excluded.bounty_mass_claimed
+`
+
+type RecordBountyClaimParams struct {
+	PlayerID          int64
+	BountyMassClaimed int64
+}
+
+func (q *Queries) RecordBountyClaim(ctx context.Context, arg RecordBountyClaimParams) error {
+	_, err := q.db.ExecContext(ctx, recordBountyClaim, arg.PlayerID, arg.BountyMassClaimed)
+	return err
+}
+
+const updatePlayerBestCombo = `-- name: UpdatePlayerBestCombo :exec
+INSERT INTO combo_records (
+    player_id, best_combo
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE
+SET best_combo = MAX(best_combo, excluded.best_combo)
+`
+
+type UpdatePlayerBestComboParams struct {
+	PlayerID  int64
+	BestCombo int64
+}
+
+func (q *Queries) UpdatePlayerBestCombo(ctx context.Context, arg UpdatePlayerBestComboParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerBestCombo, arg.PlayerID, arg.BestCombo)
+	return err
+}
+
 const updatePlayerBestScore = `-- name: UpdatePlayerBestScore :exec
 UPDATE players
 SET best_score = ?
@@ -178,3 +432,23 @@ func (q *Queries) UpdatePlayerBestScore(ctx context.Context, arg UpdatePlayerBes
 	_, err := q.db.ExecContext(ctx, updatePlayerBestScore, arg.BestScore, arg.ID)
 	return err
 }
+
+const updatePlayerBestZoneScore = `-- name: UpdatePlayerBestZoneScore :exec
+INSERT INTO zone_scores (
+    player_id, best_score
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE
+SET best_score = MAX(best_score, excluded.best_score)
+`
+
+type UpdatePlayerBestZoneScoreParams struct {
+	PlayerID  int64
+	BestScore int64
+}
+
+func (q *Queries) UpdatePlayerBestZoneScore(ctx context.Context, arg UpdatePlayerBestZoneScoreParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerBestZoneScore, arg.PlayerID, arg.BestScore)
+	return err
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+const (
+	// The mass of a typical spore, which the arena's spore budget is measured in
+	averageSporeMass = math.Pi * 10 * 10
+
+	// The total mass the world aims to hold, players' and spores' together, as a share of what the
+	// arena's spores could hold at most, for an empty world plus each player in it
+	emptyWorldMassShare     = 0.3
+	worldMassSharePerPlayer = 0.1
+
+	// However much of the world's mass players hold, spores make up at least this share of the most
+	// they could
+	minSporeMassShare = 0.1
+
+	// The most spores that are spawned per second, spread over the ticks of the economy loop
+	sporeSpawnRate = 50.0
+
+	// How often the world's mass is logged
+	worldMassLogInterval = time.Minute
+)
+
+// A snapshot of how much mass the world holds and how much it is aiming for
+type MassMetrics struct {
+	PlayerMass float64 `json:"player_mass"`
+	SporeMass  float64 `json:"spore_mass"`
+	Spores     int     `json:"spores"`
+	Players    int     `json:"players"`
+
+	// The mass the spores are being spawned up to
+	TargetSporeMass float64 `json:"target_spore_mass"`
+
+	// How many dropped spores have been cleared away uneaten since the arena started
+	SporesExpired int64 `json:"spores_expired"`
+}
+
+// The total mass in the world
+func (m MassMetrics) TotalMass() float64 {
+	return m.PlayerMass + m.SporeMass
+}
+
+// WorldMass measures the mass held by the world's players and spores
+func (a *Arena) WorldMass() MassMetrics {
+	metrics := MassMetrics{}
+	a.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		metrics.PlayerMass += player.PublishedMass()
+		metrics.Players++
+	})
+	a.SharedGameObjects.Spores.ForEach(func(_ uint64, spore *objects.Spore) {
+		metrics.SporeMass += math.Pi * spore.Radius * spore.Radius
+		metrics.Spores++
+	})
+	metrics.TargetSporeMass = a.targetSporeMass(metrics.Players, metrics.PlayerMass)
+	metrics.SporesExpired = a.sporesExpired.Load()
+	return metrics
+}
+
+// ServeMetrics writes the world mass of every running public arena as JSON, keyed by arena ID, for
+// operators to keep an eye on the economy without trawling the logs. Private arenas are nobody
+// else's business.
+func (h *Hub) ServeMetrics(writer http.ResponseWriter, _ *http.Request) {
+	metrics := make(map[uint64]MassMetrics)
+	h.Arenas.ForEach(func(arenaId uint64, arena *Arena) {
+		if !arena.Private {
+			metrics[arenaId] = arena.WorldMass()
+		}
+	})
+
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(metrics); err != nil {
+		log.Printf("Error writing world mass metrics: %v", err)
+	}
+}
+
+// The world's mass budget grows with its population, and spores make up whatever the players
+// don't already hold, within the arena's limits
+func (a *Arena) targetSporeMass(players int, playerMass float64) float64 {
+	capacity := float64(a.MaxSpores) * averageSporeMass
+	worldMass := capacity * (emptyWorldMassShare + worldMassSharePerPlayer*float64(players))
+	return min(max(worldMass-playerMass, capacity*minSporeMassShare), capacity)
+}
+
+// Keep the world's spores at the level its mass budget calls for, spawning a share of any
+// shortfall each tick rather than all at once, and clearing away dropped spores nobody has eaten
+func (a *Arena) economyLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	lastTick := time.Now()
+	lastLoggedAt := time.Now()
+	budget := 0.0
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			delta := now.Sub(lastTick).Seconds()
+			lastTick = now
+
+			a.expireDroppedSpores()
+
+			// Any of the tick's budget not needed to reach the target is dropped rather than saved up
+			metrics := a.WorldMass()
+			budget += sporeSpawnRate * delta
+			a.spawnSpores(metrics, int(budget))
+			budget -= math.Floor(budget)
+
+			if now.Sub(lastLoggedAt) >= worldMassLogInterval {
+				lastLoggedAt = now
+				a.logger.Printf("World mass %.0f: %d players with %.0f, %d spores with %.0f of a target %.0f, %d dropped spores expired",
+					metrics.TotalMass(), metrics.Players, metrics.PlayerMass, metrics.Spores, metrics.SporeMass, metrics.TargetSporeMass,
+					metrics.SporesExpired)
+			}
+		case <-a.ctx.Done():
+			return
+		}
+	}
+}
+
+// Spawn up to the given number of spores towards the target
+func (a *Arena) spawnSpores(metrics MassMetrics, limit int) {
+	spawned := 0
+	sporeMass := metrics.SporeMass
+	for spawned < limit && sporeMass < metrics.TargetSporeMass && metrics.Spores+spawned < a.MaxSpores {
+		spore := a.newSpore()
+		sporeId := a.SharedGameObjects.Spores.Add(spore)
+		sporeMass += math.Pi * spore.Radius * spore.Radius
+		spawned++
+
+		a.Broadcast(&packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewSpore(sporeId, spore),
+		})
+	}
+}
+
+// Spores players drop or eject don't stick around forever. Those that expire are announced
+// together, rather than as consumed, since nobody ate them.
+func (a *Arena) expireDroppedSpores() {
+	// A player may eat a spore right as it expires, in which case it's theirs rather than expired
+	expired := make([]uint64, 0)
+	a.SharedGameObjects.Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		if !spore.DroppedAt.IsZero() && time.Since(spore.DroppedAt) >= a.SporeLifetime && a.SharedGameObjects.Spores.Remove(sporeId) {
+			expired = append(expired, sporeId)
+		}
+	})
+
+	if len(expired) == 0 {
+		return
+	}
+
+	a.sporesExpired.Add(int64(len(expired)))
+
+	a.Broadcast(&packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewSporesExpired(expired),
+	})
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"fmt"
+	"server/internal/server/objects"
+)
+
+// A kind of spore and what it's worth, which arenas spawn a mix of according to the weights
+type FoodType struct {
+	Kind string `json:"kind"`
+
+	// How often this kind spawns compared to the others
+	Weight float64 `json:"weight"`
+
+	// How much mass eating the spore gives compared to a normal spore of the same size. Negative
+	// values take mass away instead.
+	Value float64 `json:"value"`
+}
+
+// The mix of food arenas spawn unless their map says otherwise
+var DefaultFoodTypes = []FoodType{
+	{Kind: objects.SporeNormal, Weight: 100, Value: 1},
+	{Kind: objects.SporeGolden, Weight: 2, Value: 10},
+	{Kind: objects.SporePoison, Weight: 5, Value: -2},
+	{Kind: objects.SporePrey, Weight: 5, Value: 3},
+}
+
+func (f FoodType) validate() error {
+	switch f.Kind {
+	case objects.SporeNormal, objects.SporeGolden, objects.SporePoison, objects.SporePrey:
+	default:
+		return fmt.Errorf("unknown food kind %q", f.Kind)
+	}
+
+	if f.Weight < 0 {
+		return fmt.Errorf("food %q can't have a negative weight", f.Kind)
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package server
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"sync"
+	"time"
+)
+
+// The names arenas pick their game mode by
+const (
+	ModeFreeForAll   = "ffa"
+	ModeTeams        = "teams"
+	ModeBattleRoyale = "battle_royale"
+	ModeTimedRounds  = "timed_rounds"
+	ModeInfection    = "infection"
+)
+
+const (
+	// How long it takes the battle royale safe zone to shrink to its smallest by default, how small
+	// that is, and how long the last players get to fight it out once it has
+	safeZoneShrinkTime = 3 * time.Minute
+	minSafeZoneRadius  = 200.0
+	safeZoneFinalTime  = 30 * time.Second
+
+	// The share of their mass cells outside the safe zone lose per second, down to a minimum size
+	safeZoneDrainRate     = 0.1
+	safeZoneMinCellRadius = 10.0
+
+	// How often the safe zone is sent to clients while it shrinks
+	safeZoneSyncInterval = time.Second
+
+	// How long each round lasts in the timed rounds mode by default
+	timedRoundLength = 5 * time.Minute
+)
+
+// The rules of an arena, which decide who can eat whom, how players are scored, and when rounds
+// end. The hooks taking a player are called from that player's own update loop, the others from
+// the arena's.
+type GameMode interface {
+	Name() string
+
+	// Messages describing the mode's current state, sent to clients as they join the arena
+	Status() []packets.Msg
+
+	// Called when a player spawns, before they are added to the world
+	OnSpawn(playerId uint64, player *objects.Player)
+
+	// Called regularly to advance the mode's own state, e.g. its timers
+	Tick(delta float64)
+
+	// Called on every update of a player, to apply the mode's rules to them
+	TickPlayer(playerId uint64, player *objects.Player, delta float64)
+
+	// Whether the eater is allowed to consume the victim
+	CanConsume(eater *objects.Player, victim *objects.Player) bool
+
+	// The current scores, best first
+	Standings() []*packets.StandingMessage
+
+	// Reports whether the round has ended and who won it, in which case the next round starts
+	EndOfRound() (winner string, over bool)
+}
+
+var gameModes = map[string]func(arena *Arena) GameMode{
+	ModeFreeForAll:   func(arena *Arena) GameMode { return &freeForAll{arena: arena} },
+	ModeTeams:        func(arena *Arena) GameMode { return &teams{freeForAll: freeForAll{arena: arena}} },
+	ModeBattleRoyale: func(arena *Arena) GameMode { return newBattleRoyale(arena) },
+	ModeTimedRounds:  func(arena *Arena) GameMode { return newTimedRounds(arena) },
+	ModeInfection:    func(arena *Arena) GameMode { return newInfection(arena) },
+}
+
+// Implemented by modes where players tag each other by touch, rather than eating each other
+type TagMode interface {
+	// Whether touching the target tags them
+	CanTag(tagger *objects.Player, target *objects.Player) bool
+
+	// Called once the target has been tagged
+	Tag(targetId uint64, target *objects.Player)
+}
+
+// How long the arena's rounds last, which is up to the mode unless the arena says otherwise
+func (a *Arena) roundTime(modeRoundTime time.Duration) time.Duration {
+	if a.RoundTime > 0 {
+		return a.RoundTime
+	}
+	return modeRoundTime
+}
+
+func newGameMode(name string, arena *Arena) (GameMode, error) {
+	newMode, exists := gameModes[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown game mode %q", name)
+	}
+	return newMode(arena), nil
+}
+
+// Every player for themselves, with no rounds. The other modes build on this one.
+type freeForAll struct {
+	arena *Arena
+}
+
+func (m *freeForAll) Name() string {
+	return ModeFreeForAll
+}
+
+func (m *freeForAll) Status() []packets.Msg {
+	return []packets.Msg{packets.NewGameMode(ModeFreeForAll, 0, false)}
+}
+
+func (m *freeForAll) OnSpawn(playerId uint64, player *objects.Player) {
+	player.Team = 0
+}
+
+func (m *freeForAll) Tick(delta float64) {}
+
+func (m *freeForAll) TickPlayer(playerId uint64, player *objects.Player, delta float64) {}
+
+func (m *freeForAll) CanConsume(eater *objects.Player, victim *objects.Player) bool {
+	return true
+}
+
+func (m *freeForAll) Standings() []*packets.StandingMessage {
+	standings := make([]*packets.StandingMessage, 0, m.arena.SharedGameObjects.Players.Len())
+	m.arena.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		standings = append(standings, &packets.StandingMessage{
+			Name:  player.Name,
+			Score: uint64(math.Round(player.PublishedMass())),
+		})
+	})
+	sortStandings(standings)
+	return standings
+}
+
+func (m *freeForAll) EndOfRound() (string, bool) {
+	return "", false
+}
+
+// Returns the name of whoever is in the lead, or an empty string if nobody is playing
+func (m *freeForAll) leader(standings []*packets.StandingMessage) string {
+	if len(standings) == 0 {
+		return ""
+	}
+	return standings[0].Name
+}
+
+// A safe zone shrinks over the course of the round, draining the mass of anyone caught outside it.
+// Whoever is biggest once the zone has been at its smallest for a while wins.
+type battleRoyale struct {
+	freeForAll
+
+	// Guards the zone, which is shrunk by the arena and read by every player
+	mux            sync.Mutex
+	roundStartedAt time.Time
+	zoneRadius     float64
+	lastSyncedAt   time.Time
+}
+
+func newBattleRoyale(arena *Arena) *battleRoyale {
+	m := &battleRoyale{freeForAll: freeForAll{arena: arena}}
+	m.startRound()
+	return m
+}
+
+func (m *battleRoyale) Name() string {
+	return ModeBattleRoyale
+}
+
+func (m *battleRoyale) Status() []packets.Msg {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	return []packets.Msg{
+		packets.NewGameMode(ModeBattleRoyale, time.Until(m.roundStartedAt.Add(m.shrinkTime()+safeZoneFinalTime)), false),
+		packets.NewSafeZone(0, 0, m.zoneRadius),
+	}
+}
+
+func (m *battleRoyale) Tick(delta float64) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	progress := min(float64(time.Since(m.roundStartedAt))/float64(m.shrinkTime()), 1)
+	startRadius := m.startRadius()
+	m.zoneRadius = startRadius - (startRadius-minSafeZoneRadius)*progress
+
+	if time.Since(m.lastSyncedAt) >= safeZoneSyncInterval {
+		m.lastSyncedAt = time.Now()
+		go m.arena.Broadcast(&packets.Packet{SenderId: 0, Msg: packets.NewSafeZone(0, 0, m.zoneRadius)})
+	}
+}
+
+func (m *battleRoyale) TickPlayer(playerId uint64, player *objects.Player, delta float64) {
+	m.mux.Lock()
+	zoneRadius := m.zoneRadius
+	m.mux.Unlock()
+
+	drain := func(cell *objects.Cell) {
+		if m.arena.World.Dist(0, 0, cell.X, cell.Y) > zoneRadius {
+			cell.Radius = max(cell.Radius*math.Sqrt(1-safeZoneDrainRate*delta), safeZoneMinCellRadius)
+		}
+	}
+
+	drain(&player.Cell)
+	player.Cells.ForEach(func(_ uint64, cell *objects.Cell) { drain(cell) })
+}
+
+func (m *battleRoyale) EndOfRound() (string, bool) {
+	m.mux.Lock()
+	over := time.Since(m.roundStartedAt) >= m.shrinkTime()+safeZoneFinalTime
+	m.mux.Unlock()
+
+	if !over {
+		return "", false
+	}
+
+	winner := m.leader(m.Standings())
+	m.startRound()
+	return winner, true
+}
+
+func (m *battleRoyale) startRound() {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.roundStartedAt = time.Now()
+	m.zoneRadius = m.startRadius()
+}
+
+// How long the zone takes to shrink to its smallest
+func (m *battleRoyale) shrinkTime() time.Duration {
+	return m.arena.roundTime(safeZoneShrinkTime)
+}
+
+// The zone starts out covering the whole world
+func (m *battleRoyale) startRadius() float64 {
+	if m.arena.World.Shape == objects.WorldCircle {
+		return m.arena.World.Size
+	}
+	return m.arena.World.Size * math.Sqrt2
+}
+
+// Free-for-all in fixed-length rounds, won by whoever is biggest when the time runs out
+type timedRounds struct {
+	freeForAll
+
+	// Guards the start of the round, which is moved on by the arena and read by joining players
+	mux            sync.Mutex
+	roundStartedAt time.Time
+}
+
+func newTimedRounds(arena *Arena) *timedRounds {
+	return &timedRounds{
+		freeForAll:     freeForAll{arena: arena},
+		roundStartedAt: time.Now(),
+	}
+}
+
+func (m *timedRounds) Name() string {
+	return ModeTimedRounds
+}
+
+func (m *timedRounds) Status() []packets.Msg {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	return []packets.Msg{packets.NewGameMode(ModeTimedRounds, time.Until(m.roundEndsAt()), false)}
+}
+
+func (m *timedRounds) EndOfRound() (string, bool) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if time.Now().Before(m.roundEndsAt()) {
+		return "", false
+	}
+
+	m.roundStartedAt = time.Now()
+	return m.leader(m.Standings()), true
+}
+
+func (m *timedRounds) roundEndsAt() time.Time {
+	return m.roundStartedAt.Add(m.arena.roundTime(timedRoundLength))
+}
+
+func sortStandings(standings []*packets.StandingMessage) {
+	slices.SortStableFunc(standings, func(a, b *packets.StandingMessage) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"errors"
+	"fmt"
 	"log"
 	"math/rand/v2"
 	"net/http"
@@ -11,12 +13,20 @@ import (
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
+	"strings"
+	"sync"
 	"time"
 
 	_ "modernc.org/sqlite"
 )
 
-const MaxSpores = 1000
+const (
+	inviteCodeLength        = 6
+	privateArenaIdleTimeout = 5 * time.Minute
+
+	// The most private arenas a player can host at once
+	maxPrivateArenasPerPlayer = 3
+)
 
 //go:embed db/config/schema.sql
 var schemaGenSql string
@@ -24,19 +34,38 @@ var schemaGenSql string
 type DbTx struct {
 	Ctx     context.Context
 	Queries *db.Queries
+	dbPool  *sql.DB
 }
 
 func (h *Hub) NewDbTx() *DbTx {
 	return &DbTx{
 		Ctx:     context.Background(),
 		Queries: db.New(h.dbPool),
+		dbPool:  h.dbPool,
 	}
 }
 
+// InTransaction runs the given queries together in one transaction, which is committed if they
+// all succeed and rolled back otherwise
+func (d *DbTx) InTransaction(queries func(queries *db.Queries) error) error {
+	tx, err := d.dbPool.BeginTx(d.Ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := queries(d.Queries.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 type SharedGameObjects struct {
 	// The ID of the player is the ID of the client that owns it
-	Players *objects.SharedCollection[*objects.Player]
-	Spores  *objects.SharedCollection[*objects.Spore]
+	Players  *objects.SharedCollection[*objects.Player]
+	Spores   *objects.SharedCollection[*objects.Spore]
+	Hazards  *objects.SharedCollection[*objects.Hazard]
+	PowerUps *objects.SharedCollection[*objects.PowerUp]
 }
 
 // A structure for a state machine to process the client's messages
@@ -83,6 +112,31 @@ type ClientInterfacer interface {
 	// A reference to the database transaction context for this client
 	DbTx() *DbTx
 
+	// The arena the client is currently playing in, or nil if it hasn't joined one
+	Arena() *Arena
+
+	// Join the arena with the given ID, or any arena with room if the ID is 0
+	JoinArena(arenaId uint64) error
+
+	// Join the private arena with the given invite code, playing as the player with the given account
+	JoinPrivateArena(inviteCode string, playerId int64) error
+
+	// Watch an arena without playing, chosen by invite code, or by ID with 0 being the most
+	// populated public arena. Private arenas can only be watched by players who have logged in with
+	// the given account. Leaves the client's current arena if it is a different one.
+	SpectateArena(arenaId uint64, inviteCode string, playerId int64) error
+
+	// Create a private arena hosted by this client on behalf of the player with the given account,
+	// which it can then join by the invite code
+	CreatePrivateArena(playerId int64, settings ArenaSettings) (*Arena, error)
+
+	// Leave the client's current arena, if any
+	LeaveArena()
+
+	// All the arenas currently hosted by the hub
+	Arenas() *objects.SharedCollection[*Arena]
+
+	// The game objects of the client's current arena, or nil if it hasn't joined one
 	SharedGameObjects() *SharedGameObjects
 
 	// Close the client's connections and cleanup
@@ -105,11 +159,22 @@ type Hub struct {
 	// Database connection pool
 	dbPool *sql.DB
 
-	SharedGameObjects *SharedGameObjects
+	// The independent worlds hosted by the hub, each with its own players and spores
+	Arenas *objects.SharedCollection[*Arena]
+
+	// The maps arenas can be laid out with, by name
+	Maps map[string]*GameMap
+
+	// The power-ups spawned in every arena
+	PowerUps []PowerUpEffect
+
+	// Guards joining and leaving arenas, so that arenas are created and torn down consistently
+	arenasMux sync.Mutex
 }
 
 func NewHub(dataDirPath string) *Hub {
-	dbPool, err := sql.Open("sqlite", path.Join(dataDirPath, "db.sqlite"))
+	// Clients write to the database concurrently, so wait for the lock rather than failing straight away
+	dbPool, err := sql.Open("sqlite", path.Join(dataDirPath, "db.sqlite")+"?_pragma=busy_timeout(5000)")
 	if err != nil {
 		log.Fatalf("Error opening database: %v", err)
 	}
@@ -120,10 +185,9 @@ func NewHub(dataDirPath string) *Hub {
 		RegisterChan:   make(chan ClientInterfacer),
 		UnregisterChan: make(chan ClientInterfacer),
 		dbPool:         dbPool,
-		SharedGameObjects: &SharedGameObjects{
-			Players: objects.NewSharedCollection[*objects.Player](),
-			Spores:  objects.NewSharedCollection[*objects.Spore](),
-		},
+		Arenas:         objects.NewSharedCollection[*Arena](),
+		Maps:           loadMaps(path.Join(dataDirPath, "maps")),
+		PowerUps:       loadPowerUps(path.Join(dataDirPath, "powerups.json")),
 	}
 }
 
@@ -133,13 +197,6 @@ func (h *Hub) Run() {
 		log.Fatalf("Error initializing database: %v", err)
 	}
 
-	log.Println("Placing spores...")
-	for i := 0; i < MaxSpores; i++ {
-		h.SharedGameObjects.Spores.Add(h.newSpore())
-	}
-
-	go h.replenishSporesLoop(2 * time.Second)
-
 	log.Println("Awaiting client registrations")
 	for {
 		select {
@@ -172,38 +229,223 @@ func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request)
 	go client.ReadPump()
 }
 
-func (h *Hub) newSpore() *objects.Spore {
-	sporeRadius := max(10+rand.NormFloat64()*3, 5)
-	x, y := objects.SpawnCoords(sporeRadius, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
-	return &objects.Spore{X: x, Y: y, Radius: sporeRadius}
+// JoinArena adds the client to the arena with the given ID, or to the first arena with room
+// for another player if the ID is 0. A new arena is created if all the existing ones are full.
+func (h *Hub) JoinArena(client ClientInterfacer, arenaId uint64) (*Arena, error) {
+	h.arenasMux.Lock()
+	defer h.arenasMux.Unlock()
+
+	var arena *Arena
+	if arenaId != 0 {
+		found, exists := h.Arenas.Get(arenaId)
+		if !exists || found.Private {
+			return nil, fmt.Errorf("arena %d does not exist", arenaId)
+		}
+		if found.IsFull() {
+			return nil, errors.New("arena is full")
+		}
+		arena = found
+	} else {
+		arena = h.findOpenArena()
+		if arena == nil {
+			arena = h.createArena()
+		}
+	}
+
+	arena.Clients.Add(client, client.Id())
+	return arena, nil
 }
 
-func (h *Hub) replenishSporesLoop(rate time.Duration) {
-	ticker := time.NewTicker(rate)
-	defer ticker.Stop()
+// JoinPrivateArena adds the client to the private arena with the given invite code, unless the
+// player with the given account has been kicked from it
+func (h *Hub) JoinPrivateArena(client ClientInterfacer, inviteCode string, playerId int64) (*Arena, error) {
+	h.arenasMux.Lock()
+	defer h.arenasMux.Unlock()
 
-	for range ticker.C {
-		sporesRemaining := h.SharedGameObjects.Spores.Len()
-		diff := MaxSpores - sporesRemaining
+	arena := h.findPrivateArena(strings.ToUpper(strings.TrimSpace(inviteCode)))
+	if arena == nil {
+		return nil, errors.New("invalid invite code")
+	}
+	if arena.IsKicked(playerId) {
+		return nil, errors.New("kicked from this arena")
+	}
+	if arena.IsFull() {
+		return nil, errors.New("arena is full")
+	}
+
+	arena.Clients.Add(client, client.Id())
+	arena.addMember(client.Id(), playerId)
+	return arena, nil
+}
 
-		if diff <= 0 {
-			continue
+// CreatePrivateArena creates an empty arena which can only be joined by its invite code, hosted
+// by the client with the given ID on behalf of the player with the given account. The arena is
+// torn down if nobody joins it for a while.
+func (h *Hub) CreatePrivateArena(hostId uint64, hostPlayerId int64, settings ArenaSettings) (*Arena, error) {
+	h.arenasMux.Lock()
+	defer h.arenasMux.Unlock()
+
+	hosted := 0
+	h.Arenas.ForEach(func(_ uint64, arena *Arena) {
+		if arena.Private && arena.hostedBy(hostPlayerId) {
+			hosted++
 		}
+	})
+	if hosted >= maxPrivateArenasPerPlayer {
+		return nil, fmt.Errorf("already hosting %d private arenas", hosted)
+	}
+
+	arena := newArena()
+	if err := arena.applySettings(settings, h.Maps); err != nil {
+		return nil, err
+	}
 
-		log.Printf("%d spores remain - going to replenish %d spores", sporesRemaining, diff)
+	arena.Private = true
+	arena.setHost(hostId, hostPlayerId)
+	for arena.InviteCode == "" || h.findPrivateArena(arena.InviteCode) != nil {
+		arena.InviteCode = newInviteCode()
+	}
 
-		// Don't really want to spawn too many at a time, otherwise it can cause lag spikes
-		for i := 0; i < min(diff, 10); i++ {
-			spore := h.newSpore()
-			sporeId := h.SharedGameObjects.Spores.Add(spore)
+	h.startArena(arena)
 
-			h.BroadcastChan <- &packets.Packet{
-				SenderId: 0,
-				Msg:      packets.NewSpore(sporeId, spore),
+	time.AfterFunc(privateArenaIdleTimeout, func() {
+		h.arenasMux.Lock()
+		defer h.arenasMux.Unlock()
+		h.tearDownIfEmpty(arena)
+	})
+
+	return arena, nil
+}
+
+// SpectateArena adds the client as a spectator to the private arena with the given invite code
+// if there is one, otherwise to the public arena with the given ID, or to the most populated
+// public arena if the ID is 0. Private arenas can only be watched on behalf of the player with
+// the given account, unless they've been kicked from it. If the client was playing in that arena,
+// it stops playing.
+func (h *Hub) SpectateArena(client ClientInterfacer, arenaId uint64, inviteCode string, playerId int64) (*Arena, error) {
+	h.arenasMux.Lock()
+	defer h.arenasMux.Unlock()
+
+	var arena *Arena
+	if inviteCode != "" {
+		arena = h.findPrivateArena(strings.ToUpper(strings.TrimSpace(inviteCode)))
+		if arena == nil {
+			return nil, errors.New("invalid invite code")
+		}
+		if playerId == 0 {
+			return nil, errors.New("log in to watch a private arena")
+		}
+		if arena.IsKicked(playerId) {
+			return nil, errors.New("kicked from this arena")
+		}
+		arena.addMember(client.Id(), playerId)
+	} else if arenaId != 0 {
+		found, exists := h.Arenas.Get(arenaId)
+		if !exists || found.Private {
+			return nil, fmt.Errorf("arena %d does not exist", arenaId)
+		}
+		arena = found
+	} else {
+		h.Arenas.ForEach(func(_ uint64, candidate *Arena) {
+			if !candidate.Private && (arena == nil || candidate.Clients.Len() > arena.Clients.Len()) {
+				arena = candidate
 			}
+		})
+		if arena == nil {
+			return nil, errors.New("there are no arenas to spectate")
+		}
+	}
+
+	arena.Clients.Remove(client.Id())
+	arena.Spectators.Add(client, client.Id())
+	return arena, nil
+}
+
+// LeaveArena removes the client from the arena, and tears the arena down if it's now empty
+func (h *Hub) LeaveArena(client ClientInterfacer, arena *Arena) {
+	h.arenasMux.Lock()
+	defer h.arenasMux.Unlock()
+
+	arena.Clients.Remove(client.Id())
+	arena.Spectators.Remove(client.Id())
+	arena.removeMember(client.Id())
+
+	// Hand a private arena over to another player if the host leaves, so it counts toward the new
+	// host's arenas rather than theirs
+	if arena.Private && arena.HostId() == client.Id() {
+		newHostId := uint64(0)
+		arena.Clients.ForEach(func(clientId uint64, _ ClientInterfacer) {
+			if newHostId == 0 || clientId < newHostId {
+				newHostId = clientId
+			}
+		})
+		newHostPlayerId, _ := arena.AccountOf(newHostId)
+		arena.setHost(newHostId, newHostPlayerId)
+	}
+
+	h.tearDownIfEmpty(arena)
+}
+
+func (h *Hub) tearDownIfEmpty(arena *Arena) {
+	if !arena.IsEmpty() {
+		return
+	}
+	if _, exists := h.Arenas.Get(arena.Id); !exists {
+		return
+	}
+
+	log.Printf("Arena %d is empty, tearing it down", arena.Id)
+	h.Arenas.Remove(arena.Id)
+	arena.stop()
+}
+
+func (h *Hub) findPrivateArena(inviteCode string) *Arena {
+	var found *Arena
+	h.Arenas.ForEach(func(_ uint64, arena *Arena) {
+		if arena.Private && arena.InviteCode == inviteCode {
+			found = arena
+		}
+	})
+	return found
+}
 
-			// Sleep a little bit to avoid lag spikes
-			time.Sleep(50 * time.Millisecond)
+// Returns the open arena with the lowest ID, so players are packed into existing arenas first
+func (h *Hub) findOpenArena() *Arena {
+	var open *Arena
+	h.Arenas.ForEach(func(arenaId uint64, arena *Arena) {
+		if !arena.Private && !arena.IsFull() && (open == nil || arenaId < open.Id) {
+			open = arena
 		}
+	})
+	return open
+}
+
+func (h *Hub) createArena() *Arena {
+	arena := newArena()
+	if gameMap, exists := h.Maps[defaultMapName]; exists {
+		arena.applyMap(gameMap)
+	}
+	h.startArena(arena)
+	return arena
+}
+
+func (h *Hub) startArena(arena *Arena) {
+	arena.PowerUps = h.PowerUps
+	arena.dbTx = h.NewDbTx()
+	arena.Id = h.Arenas.Add(arena)
+	arena.logger.SetPrefix(fmt.Sprintf("Arena %d: ", arena.Id))
+	log.Printf("Created arena %d", arena.Id)
+
+	arena.placeObjects()
+	go arena.Run()
+}
+
+// Generates a short code that is easy to read out loud, without ambiguous characters like 0 and O
+func newInviteCode() string {
+	const alphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	code := make([]byte, inviteCodeLength)
+	for i := range code {
+		code[i] = alphabet[rand.IntN(len(alphabet))]
 	}
+	return string(code)
 }
@@ -0,0 +1,269 @@
+package server
+
+import (
+	"math/rand/v2"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// How many players need to be in the lobby to start a round
+	infectionMinPlayers = 2
+
+	// How long the healthy players need to survive to win by default
+	infectionRoundLength = 3 * time.Minute
+
+	// One in this many players starts the round infected, and always at least one
+	playersPerInfected = 5
+
+	// How often the round timer is sent to clients
+	infectionSyncInterval = time.Second
+)
+
+// Nobody eats anyone: the infected spread the infection by touching the healthy instead, and the
+// last ones standing win. Rounds start with a lobby countdown once enough players have joined.
+type infection struct {
+	freeForAll
+
+	// Guards the round, which the arena moves on while players tag each other
+	mux            sync.Mutex
+	lobby          bool
+	phaseEndsAt    time.Time
+	roundStartedAt time.Time
+	roundEndedAt   time.Time
+	lastSyncedAt   time.Time
+
+	// When each player was infected in the current or last round
+	infectedAt map[uint64]time.Time
+
+	// The name of the last player to be infected, who wins if nobody survives
+	lastInfected string
+}
+
+func newInfection(arena *Arena) *infection {
+	return &infection{
+		freeForAll:  freeForAll{arena: arena},
+		lobby:       true,
+		phaseEndsAt: time.Now().Add(arena.LobbyTime),
+		infectedAt:  make(map[uint64]time.Time),
+	}
+}
+
+func (m *infection) Name() string {
+	return ModeInfection
+}
+
+func (m *infection) Status() []packets.Msg {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	return []packets.Msg{packets.NewGameMode(ModeInfection, time.Until(m.phaseEndsAt), m.lobby)}
+}
+
+// Players joining in the lobby start healthy, but anyone arriving mid-round has already caught it
+func (m *infection) OnSpawn(playerId uint64, player *objects.Player) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	player.Team = 0
+	if m.lobby {
+		player.SetRole(objects.RoleHealthy)
+	} else {
+		player.SetRole(objects.RoleInfected)
+		m.infectedAt[playerId] = time.Now()
+	}
+}
+
+func (m *infection) Tick(delta float64) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if m.lobby {
+		// Hold the countdown until there are enough players for a round
+		if m.arena.SharedGameObjects.Players.Len() < infectionMinPlayers {
+			m.phaseEndsAt = time.Now().Add(m.arena.LobbyTime)
+		} else if time.Now().After(m.phaseEndsAt) {
+			m.startRound()
+			m.lastSyncedAt = time.Time{}
+		}
+	}
+
+	if time.Since(m.lastSyncedAt) >= infectionSyncInterval {
+		m.lastSyncedAt = time.Now()
+		go m.arena.Broadcast(&packets.Packet{SenderId: 0, Msg: packets.NewGameMode(ModeInfection, time.Until(m.phaseEndsAt), m.lobby)})
+	}
+}
+
+// Infect a random few of the players and start the clock
+func (m *infection) startRound() {
+	playerIds := make([]uint64, 0, m.arena.SharedGameObjects.Players.Len())
+	m.arena.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		player.SetRole(objects.RoleHealthy)
+		playerIds = append(playerIds, playerId)
+	})
+	rand.Shuffle(len(playerIds), func(i, j int) { playerIds[i], playerIds[j] = playerIds[j], playerIds[i] })
+
+	now := time.Now()
+	clear(m.infectedAt)
+	for _, playerId := range playerIds[:max(len(playerIds)/playersPerInfected, 1)] {
+		if player, exists := m.arena.SharedGameObjects.Players.Get(playerId); exists {
+			player.SetRole(objects.RoleInfected)
+			m.infectedAt[playerId] = now
+		}
+	}
+
+	m.lobby = false
+	m.roundStartedAt = now
+	m.phaseEndsAt = now.Add(m.arena.roundTime(infectionRoundLength))
+	m.lastInfected = ""
+	m.arena.logger.Printf("Infection round started with %d players", len(playerIds))
+}
+
+func (m *infection) CanConsume(eater *objects.Player, victim *objects.Player) bool {
+	return false
+}
+
+func (m *infection) CanTag(tagger *objects.Player, target *objects.Player) bool {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	return !m.lobby && tagger.Role() == objects.RoleInfected && target.Role() == objects.RoleHealthy
+}
+
+func (m *infection) Tag(targetId uint64, target *objects.Player) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if m.lobby || target.Role() != objects.RoleHealthy {
+		return
+	}
+	target.SetRole(objects.RoleInfected)
+	m.infectedAt[targetId] = time.Now()
+	m.lastInfected = target.Name
+}
+
+// Players are ranked by how long they stayed healthy, in seconds
+func (m *infection) Standings() []*packets.StandingMessage {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	standings := make([]*packets.StandingMessage, 0, m.arena.SharedGameObjects.Players.Len())
+	m.arena.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		standings = append(standings, &packets.StandingMessage{
+			Name:  player.Name,
+			Score: uint64(m.survived(playerId).Seconds()),
+		})
+	})
+	sortStandings(standings)
+	return standings
+}
+
+// How long the player has stayed healthy in the current or last round
+func (m *infection) survived(playerId uint64) time.Duration {
+	if m.roundStartedAt.IsZero() {
+		return 0
+	}
+
+	until := time.Now()
+	if m.lobby {
+		until = m.roundEndedAt
+	}
+	if infectedAt, infected := m.infectedAt[playerId]; infected {
+		until = infectedAt
+	}
+	return max(until.Sub(m.roundStartedAt), 0)
+}
+
+// The round is over once everyone is infected, or the healthy have held out until the end. Either
+// way, the result is recorded and everyone goes back to the lobby.
+func (m *infection) EndOfRound() (string, bool) {
+	winner, result, over := m.endRound()
+	if over {
+		m.saveMatch(result)
+	}
+	return winner, over
+}
+
+// The result of a round, as it is recorded in the match history
+type matchResult struct {
+	match   db.CreateMatchParams
+	players []db.CreateMatchPlayerParams
+}
+
+// Send everyone back to the lobby if the round is over, returning its result to be recorded
+func (m *infection) endRound() (string, matchResult, bool) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if m.lobby {
+		return "", matchResult{}, false
+	}
+
+	survivors := make([]string, 0)
+	m.arena.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		if player.Role() == objects.RoleHealthy {
+			survivors = append(survivors, player.Name)
+		}
+	})
+	if len(survivors) > 0 && time.Now().Before(m.phaseEndsAt) {
+		return "", matchResult{}, false
+	}
+
+	winner := m.lastInfected
+	if len(survivors) > 0 {
+		winner = strings.Join(survivors, ", ")
+	}
+
+	m.lobby = true
+	m.roundEndedAt = time.Now()
+	m.phaseEndsAt = m.roundEndedAt.Add(m.arena.LobbyTime)
+	m.lastSyncedAt = time.Time{}
+
+	result := matchResult{
+		match: db.CreateMatchParams{
+			Mode:      ModeInfection,
+			Winner:    winner,
+			StartedAt: m.roundStartedAt.Unix(),
+			EndedAt:   m.roundEndedAt.Unix(),
+		},
+	}
+	m.arena.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		result.players = append(result.players, db.CreateMatchPlayerParams{
+			PlayerID: player.DbId,
+			Role:     player.Role(),
+			Score:    m.survived(playerId).Milliseconds(),
+		})
+	})
+	return winner, result, true
+}
+
+// Record the result of a round in the match history, all at once or not at all, unless the
+// arena keeps its results to itself
+func (m *infection) saveMatch(result matchResult) {
+	if m.arena.dbTx == nil || !m.arena.RecordHiscores {
+		return
+	}
+
+	ctx := m.arena.dbTx.Ctx
+	err := m.arena.dbTx.InTransaction(func(queries *db.Queries) error {
+		match, err := queries.CreateMatch(ctx, result.match)
+		if err != nil {
+			return err
+		}
+
+		for _, player := range result.players {
+			player.MatchID = match.ID
+			if err := queries.CreateMatchPlayer(ctx, player); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		m.arena.logger.Printf("Error saving infection match: %v", err)
+	}
+}
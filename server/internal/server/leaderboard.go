@@ -0,0 +1,73 @@
+package server
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"time"
+)
+
+// How many of the top players are shown on the live leaderboard
+const leaderboardSize = 10
+
+func (a *Arena) leaderboardLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			a.sendLeaderboard()
+			a.escalateBounties(rate.Seconds())
+		case <-a.ctx.Done():
+			return
+		}
+	}
+}
+
+// Rank everyone playing by their current mass, and send each client the top of the board along
+// with where they themselves stand. The leaderboard differs for each client, so it is sent to them
+// directly rather than broadcast.
+func (a *Arena) sendLeaderboard() {
+	entries := make([]*packets.LeaderboardEntryMessage, 0, a.SharedGameObjects.Players.Len())
+	a.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		entries = append(entries, &packets.LeaderboardEntryMessage{
+			PlayerId: playerId,
+			Name:     player.Name,
+			Mass:     uint64(math.Round(player.PublishedMass())),
+		})
+	})
+	slices.SortStableFunc(entries, func(x, y *packets.LeaderboardEntryMessage) int {
+		return cmp.Or(cmp.Compare(y.Mass, x.Mass), cmp.Compare(x.PlayerId, y.PlayerId))
+	})
+
+	ranks := make(map[uint64]int, len(entries))
+	for i, entry := range entries {
+		ranks[entry.PlayerId] = i
+	}
+	top := entries[:min(len(entries), leaderboardSize)]
+
+	// Taking the lead only counts as news when there's someone to take it from
+	if len(entries) > 1 && entries[0].PlayerId != a.leaderId {
+		a.Announce(AnnouncementNewLeader, entries[0].PlayerId, fmt.Sprintf("%s has taken the lead", entries[0].Name))
+	}
+	if len(entries) > 0 {
+		if leader, exists := a.SharedGameObjects.Players.Get(entries[0].PlayerId); exists {
+			a.checkLeaderBounty(entries[0].PlayerId, leader)
+		}
+		a.leaderId = entries[0].PlayerId
+	}
+
+	send := func(clientId uint64, client ClientInterfacer) {
+		var ownRank, ownMass uint64
+		if i, playing := ranks[clientId]; playing {
+			ownRank, ownMass = uint64(i+1), entries[i].Mass
+		}
+		client.SocketSendAs(packets.NewLeaderboard(top, ownRank, ownMass, uint64(len(entries))), 0)
+	}
+	a.Clients.ForEach(send)
+	a.Spectators.ForEach(send)
+}
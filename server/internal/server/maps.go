@@ -0,0 +1,136 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"server/internal/server/objects"
+	"slices"
+	"strings"
+)
+
+// Public arenas are laid out with the map of this name, if there is one
+const defaultMapName = "default"
+
+// A hand-designed layout for arenas, loaded from a JSON file in the maps directory
+type GameMap struct {
+	Name string `json:"name"`
+	objects.World
+
+	// The mix of food spawned on the map, or the default mix if empty
+	Food []FoodType `json:"food,omitempty"`
+
+	// The game mode played on the map, or free-for-all if empty
+	Mode string `json:"mode,omitempty"`
+
+	// How players slow down and decay as they grow on the map. Any settings left out keep their
+	// defaults.
+	SpeedCurve SpeedCurve `json:"speed_curve"`
+	MassDecay  MassDecay  `json:"mass_decay"`
+
+	// How many hazards are scattered around the map
+	MaxHazards int `json:"max_hazards"`
+
+	// How long players wait in the lobby before each round, and how long rounds last, in seconds, in
+	// modes that have them. A round time of 0 leaves it to the mode.
+	LobbyTime float64 `json:"lobby_time"`
+	RoundTime float64 `json:"round_time"`
+}
+
+func (m *GameMap) validate() error {
+	if m.Name == "" {
+		return errors.New("map has no name")
+	}
+	if m.Size <= 0 {
+		return errors.New("map size must be positive")
+	}
+
+	for _, region := range slices.Concat(m.Obstacles, m.SpawnZones, m.SporeRegions, m.ControlZones) {
+		if region.Width <= 0 || region.Height <= 0 || region.Weight < 0 {
+			return fmt.Errorf("invalid region %+v", region)
+		}
+		if !m.Encloses(region) {
+			return fmt.Errorf("region %+v lies outside the world", region)
+		}
+	}
+
+	for _, food := range m.Food {
+		if err := food.validate(); err != nil {
+			return err
+		}
+	}
+
+	if _, exists := gameModes[m.Mode]; m.Mode != "" && !exists {
+		return fmt.Errorf("unknown game mode %q", m.Mode)
+	}
+
+	if err := m.SpeedCurve.validate(); err != nil {
+		return err
+	}
+	if err := m.MassDecay.validate(); err != nil {
+		return err
+	}
+
+	if m.MaxHazards < 0 {
+		return errors.New("hazard count can't be negative")
+	}
+	if m.LobbyTime < 0 || m.RoundTime < 0 {
+		return errors.New("lobby and round times can't be negative")
+	}
+
+	return nil
+}
+
+// Load every map in the given directory, keyed by name. Maps that can't be loaded are logged and
+// skipped, and a missing directory simply means there are no maps.
+func loadMaps(dirPath string) map[string]*GameMap {
+	maps := make(map[string]*GameMap)
+
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Error reading maps directory %s: %v", dirPath, err)
+		}
+		return maps
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		filePath := path.Join(dirPath, entry.Name())
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			log.Printf("Error reading map %s: %v", filePath, err)
+			continue
+		}
+
+		gameMap := &GameMap{
+			SpeedCurve: DefaultSpeedCurve,
+			MassDecay:  DefaultMassDecay,
+			MaxHazards: DefaultMaxHazards,
+			LobbyTime:  DefaultLobbyTime.Seconds(),
+		}
+		if err := json.Unmarshal(data, gameMap); err != nil {
+			log.Printf("Error parsing map %s: %v", filePath, err)
+			continue
+		}
+		if err := gameMap.validate(); err != nil {
+			log.Printf("Invalid map %s: %v", filePath, err)
+			continue
+		}
+		if _, exists := maps[gameMap.Name]; exists {
+			log.Printf("Duplicate map name %s in %s, skipping", gameMap.Name, filePath)
+			continue
+		}
+
+		log.Printf("Loaded map %s from %s", gameMap.Name, filePath)
+		maps[gameMap.Name] = gameMap
+	}
+
+	return maps
+}
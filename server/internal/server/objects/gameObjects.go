@@ -1,23 +1,373 @@
 package objects
 
-import "time"
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// How quickly the velocity of launched cells and spores dies down, per second
+const LaunchDecayRate = 4.0
+
+// A single body of a player, which can be split into several cells
+type Cell struct {
+	X      float64
+	Y      float64
+	Radius float64
+
+	// The velocity the cell has built up steering where the player is heading
+	VelX, VelY float64
+
+	// Velocity the cell was launched with when splitting off, which decays over time
+	LaunchX float64
+	LaunchY float64
+
+	// The time after which the cell may merge back into the player's main body
+	MergeAt time.Time
+}
 
 type Player struct {
-	Name      string
-	X         float64
-	Y         float64
-	Radius    float64
+	Name string
+
+	// The main body of the player, which is cell 0
+	Cell
+
 	Direction float64
 	Speed     float64
+
+	// How hard the player is steering towards their direction, from 0 to stop to 1 for full speed
+	Intensity float64
+
 	BestScore int64
 	DbId      int64
 	Color     int32
+
+	// Cells split off from the main body, with IDs starting from 1
+	Cells *SharedCollection[*Cell]
+
+	// The team the player is on in team modes, or 0 when every player is on their own
+	Team uint32
+
+	// Points scored for holding control zones, which count separately from mass
+	ZonePoints float64
+
+	// Statistics about the player's current life
+	SpawnedAt   time.Time
+	PeakMass    float64
+	SporesEaten int
+	KillStreak  int
+
+	// The player's role, bounty, protection and power-up effects, which other players and the arena
+	// look at, and some of them change, while the player's own loop is running
+	mux            sync.Mutex
+	role           string
+	bounty         float64
+	protectedUntil time.Time
+	effects        []ActiveEffect
+
+	// Where the player's cells were when their loop last published them, main body first, along
+	// with their IDs, for other goroutines to look at while the loop keeps moving them. Once another
+	// player has eaten the main body, it's left out until the player's loop has replaced it.
+	positions       []Cell
+	positionIds     []uint64
+	mainBodyClaimed bool
+	positionMux     sync.Mutex
+
+	// Mass passed on by teammates and zone points awarded by the arena, which the player's loop has
+	// yet to take in
+	sharedMass    float64
+	awardedPoints float64
+	pendingMux    sync.Mutex
+}
+
+// The roles players can play in the infection mode
+const (
+	RoleHealthy  = "healthy"
+	RoleInfected = "infected"
+)
+
+// Mass returns the mass of the cell
+func (c *Cell) Mass() float64 {
+	return math.Pi * c.Radius * c.Radius
+}
+
+// Mass returns the total mass of all the player's cells
+func (p *Player) Mass() float64 {
+	mass := p.Cell.Mass()
+	if p.Cells != nil {
+		p.Cells.ForEach(func(_ uint64, cell *Cell) {
+			mass += cell.Mass()
+		})
+	}
+	return mass
+}
+
+// PublishPositions makes the current positions and sizes of the player's cells visible to other
+// goroutines
+func (p *Player) PublishPositions() {
+	positions := []Cell{{X: p.Cell.X, Y: p.Cell.Y, Radius: p.Cell.Radius}}
+	positionIds := []uint64{0}
+	if p.Cells != nil {
+		p.Cells.ForEach(func(cellId uint64, cell *Cell) {
+			positions = append(positions, Cell{X: cell.X, Y: cell.Y, Radius: cell.Radius})
+			positionIds = append(positionIds, cellId)
+		})
+	}
+
+	p.positionMux.Lock()
+	defer p.positionMux.Unlock()
+	if p.mainBodyClaimed {
+		positions, positionIds = positions[1:], positionIds[1:]
+	}
+	p.positions = positions
+	p.positionIds = positionIds
+}
+
+// ClaimMainBody marks the player's main body as eaten and takes it out of the published positions,
+// so nobody else can eat it until the player's loop has replaced it. It reports whether the main
+// body was still there to claim.
+func (p *Player) ClaimMainBody() bool {
+	p.positionMux.Lock()
+	defer p.positionMux.Unlock()
+	if p.mainBodyClaimed || len(p.positionIds) == 0 || p.positionIds[0] != 0 {
+		return false
+	}
+	p.mainBodyClaimed = true
+	p.positions, p.positionIds = p.positions[1:], p.positionIds[1:]
+	return true
+}
+
+// ReplaceMainBody lets the main body be published again once the player's loop has put another cell
+// in place of a claimed one
+func (p *Player) ReplaceMainBody() {
+	p.positionMux.Lock()
+	defer p.positionMux.Unlock()
+	p.mainBodyClaimed = false
+}
+
+// Position returns where the player's main body was, and how big it was, when last published. While
+// a claimed main body is being replaced, that's the first of the remaining cells.
+func (p *Player) Position() (x, y, radius float64) {
+	p.positionMux.Lock()
+	defer p.positionMux.Unlock()
+	if len(p.positions) == 0 {
+		return 0, 0, 0
+	}
+	return p.positions[0].X, p.positions[0].Y, p.positions[0].Radius
+}
+
+// Positions returns copies of the player's cells as they were when last published, main body first
+func (p *Player) Positions() []Cell {
+	p.positionMux.Lock()
+	defer p.positionMux.Unlock()
+	return p.positions
+}
+
+// PositionOf returns a copy of the player's cell with the given ID, where 0 is the main body, as it
+// was when last published
+func (p *Player) PositionOf(cellId uint64) (Cell, bool) {
+	p.positionMux.Lock()
+	defer p.positionMux.Unlock()
+	for i, id := range p.positionIds {
+		if id == cellId {
+			return p.positions[i], true
+		}
+	}
+	return Cell{}, false
+}
+
+// PublishedMass returns the total mass of the player's cells as they were when last published
+func (p *Player) PublishedMass() float64 {
+	mass := 0.0
+	for _, cell := range p.Positions() {
+		mass += cell.Mass()
+	}
+	return mass
+}
+
+// ShareMass passes mass on to the player, for their own loop to take in
+func (p *Player) ShareMass(mass float64) {
+	p.pendingMux.Lock()
+	defer p.pendingMux.Unlock()
+	p.sharedMass += mass
 }
 
+// TakeSharedMass returns the mass passed on to the player since it was last taken
+func (p *Player) TakeSharedMass() float64 {
+	p.pendingMux.Lock()
+	defer p.pendingMux.Unlock()
+	mass := p.sharedMass
+	p.sharedMass = 0
+	return mass
+}
+
+// AwardZonePoints awards the player points for holding a control zone, for their own loop to take in
+func (p *Player) AwardZonePoints(points float64) {
+	p.pendingMux.Lock()
+	defer p.pendingMux.Unlock()
+	p.awardedPoints += points
+}
+
+// TakeZonePoints returns the zone points awarded to the player since they were last taken
+func (p *Player) TakeZonePoints() float64 {
+	p.pendingMux.Lock()
+	defer p.pendingMux.Unlock()
+	points := p.awardedPoints
+	p.awardedPoints = 0
+	return points
+}
+
+// Role returns the part the player plays in modes that give players roles, e.g. RoleInfected
+func (p *Player) Role() string {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.role
+}
+
+func (p *Player) SetRole(role string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.role = role
+}
+
+// Bounty returns the mass awarded to whoever consumes the player, or 0 if there's no bounty on them
+func (p *Player) Bounty() float64 {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.bounty
+}
+
+func (p *Player) SetBounty(bounty float64) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.bounty = bounty
+}
+
+// PlaceBounty puts a bounty of the given mass on the player, unless they already have one. Returns
+// whether it did.
+func (p *Player) PlaceBounty(bounty float64) bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if p.bounty > 0 {
+		return false
+	}
+	p.bounty = bounty
+	return true
+}
+
+// TakeBounty removes the bounty from the player and returns its mass, so that it can only be
+// claimed once
+func (p *Player) TakeBounty() float64 {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	bounty := p.bounty
+	p.bounty = 0
+	return bounty
+}
+
+// RaiseBounty adds to the bounty on the player, if there is one
+func (p *Player) RaiseBounty(mass float64) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if p.bounty > 0 {
+		p.bounty += mass
+	}
+}
+
+// IsProtected reports whether the player is still protected from being consumed after spawning
+func (p *Player) IsProtected() bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return time.Now().Before(p.protectedUntil)
+}
+
+// Newly spawned players can't be consumed until the given time, or until they eat something
+func (p *Player) SetProtectedUntil(until time.Time) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.protectedUntil = until
+}
+
+// Effects returns the effects of the power-ups the player has collected, including any that have
+// worn off since
+func (p *Player) Effects() []ActiveEffect {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.effects
+}
+
+// SetEffects replaces the player's effects. The slice is never modified afterwards, so whoever got
+// hold of the old one can keep looking at it.
+func (p *Player) SetEffects(effects []ActiveEffect) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.effects = effects
+}
+
+// Effect returns the player's effect of the given kind, if it hasn't worn off yet
+func (p *Player) Effect(kind string) (ActiveEffect, bool) {
+	for _, effect := range p.Effects() {
+		if effect.Kind == kind && time.Now().Before(effect.ExpiresAt) {
+			return effect, true
+		}
+	}
+	return ActiveEffect{}, false
+}
+
+// The kinds of food spores can be. Spores dropped or ejected by players are always normal.
+const (
+	SporeNormal = ""
+	SporeGolden = "golden"
+	SporePoison = "poison"
+	SporePrey   = "prey"
+)
+
 type Spore struct {
 	X         float64
 	Y         float64
 	Radius    float64
+	Kind      string
 	DroppedBy *Player
 	DroppedAt time.Time
+
+	// Velocity the spore was launched with when ejected, which decays until it comes to rest
+	LaunchX float64
+	LaunchY float64
+}
+
+// A stationary obstacle that small cells can hide behind, but that bursts big cells which run into it
+type Hazard struct {
+	X      float64
+	Y      float64
+	Radius float64
+
+	// How many ejected spores the hazard has absorbed since it last shot off a new hazard
+	Fed int
+
+	// Velocity the hazard was shot off with, which decays until it comes to rest
+	LaunchX float64
+	LaunchY float64
+}
+
+// The kinds of power-ups, each with its own effect on the player who collects it
+const (
+	PowerUpSpeed      = "speed"
+	PowerUpShield     = "shield"
+	PowerUpMagnet     = "magnet"
+	PowerUpDoubleMass = "double_mass"
+)
+
+// A collectible that grants a temporary effect to the player who runs over it
+type PowerUp struct {
+	X      float64
+	Y      float64
+	Radius float64
+	Kind   string
+}
+
+// A power-up's effect on a player, until it wears off
+type ActiveEffect struct {
+	Kind      string
+	Strength  float64
+	ExpiresAt time.Time
 }
@@ -40,12 +40,30 @@ func (s *SharedCollection[T]) Add(obj T, id ...uint64) uint64 {
 	return thisId
 }
 
-// Remove removes an object from the map by ID, if it exists
-func (s *SharedCollection[T]) Remove(id uint64) {
+// Remove removes an object from the map by ID, if it exists. Returns whether it did, so that of
+// several callers removing the same object, only the first goes on to act on it.
+func (s *SharedCollection[T]) Remove(id uint64) bool {
 	s.mapMux.Lock()
 	defer s.mapMux.Unlock()
 
+	if _, exists := s.objectsMap[id]; !exists {
+		return false
+	}
 	delete(s.objectsMap, id)
+	return true
+}
+
+// Replace the object with the given ID, if it still exists, so that anyone holding on to the old
+// object keeps a consistent copy. Returns whether the object was replaced.
+func (s *SharedCollection[T]) Replace(id uint64, obj T) bool {
+	s.mapMux.Lock()
+	defer s.mapMux.Unlock()
+
+	if _, exists := s.objectsMap[id]; !exists {
+		return false
+	}
+	s.objectsMap[id] = obj
+	return true
 }
 
 // Call the callback function for each object in the map.
@@ -74,8 +92,10 @@ func (s *SharedCollection[T]) Get(id uint64) (T, bool) {
 	return obj, found
 }
 
-// Get the approximate number of objects in the map.
-// The reason this is approximate is because the map is read without holding the lock.
+// Get the number of objects in the map.
 func (s *SharedCollection[T]) Len() int {
+	s.mapMux.Lock()
+	defer s.mapMux.Unlock()
+
 	return len(s.objectsMap)
 }
@@ -1,13 +1,16 @@
 package objects
 
-import "math/rand/v2"
+// How far away from a larger player new players are spawned, in multiples of that player's radius
+const spawnSafetyFactor = 3.0
 
-var getPlayerPosition = func(p *Player) (float64, float64) { return p.X, p.Y }
-var getPlayerRadius = func(p *Player) float64 { return p.Radius }
+var getPlayerPosition = func(p *Player) (float64, float64) { x, y, _ := p.Position(); return x, y }
+var getPlayerRadius = func(p *Player) float64 { _, _, radius := p.Position(); return radius }
 var getSporePosition = func(s *Spore) (float64, float64) { return s.X, s.Y }
 var getSporeRadius = func(s *Spore) float64 { return s.Radius }
+var getHazardPosition = func(h *Hazard) (float64, float64) { return h.X, h.Y }
+var getHazardRadius = func(h *Hazard) float64 { return h.Radius }
 
-func isTooClose[T any](x float64, y float64, radius float64, objects *SharedCollection[T], getPosition func(T) (float64, float64), getRadius func(T) float64) bool {
+func isTooClose[T any](world *World, x float64, y float64, radius float64, objects *SharedCollection[T], getPosition func(T) (float64, float64), getRadius func(T) float64) bool {
 	// Not too close if there are no objects
 	if objects == nil {
 		return false
@@ -22,9 +25,7 @@ func isTooClose[T any](x float64, y float64, radius float64, objects *SharedColl
 
 		objX, objY := getPosition(object)
 		objRad := getRadius(object)
-		xDst := objX - x
-		yDst := objY - y
-		dstSq := xDst*xDst + yDst*yDst
+		dstSq := world.DistSq(x, y, objX, objY)
 
 		if dstSq <= (radius+objRad)*(radius+objRad) {
 			tooClose = true
@@ -35,25 +36,72 @@ func isTooClose[T any](x float64, y float64, radius float64, objects *SharedColl
 	return tooClose
 }
 
-func SpawnCoords(radius float64, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
-	bound := 3000.0
-	const maxTries int = 25
+// Whether a spot is within reach of any cell of a player larger than the player to be spawned
+// there. The bigger the other player, the further away the spot needs to be.
+func isInDanger(world *World, x float64, y float64, radius float64, players *SharedCollection[*Player]) bool {
+	if players == nil {
+		return false
+	}
 
-	tries := 0
-	for {
-		x := bound * (2*rand.Float64() - 1)
-		y := bound * (2*rand.Float64() - 1)
+	inDanger := false
+	players.ForEach(func(_ uint64, player *Player) {
+		for _, cell := range player.Positions() {
+			safeDist := cell.Radius
+			if cell.Radius > radius {
+				safeDist *= spawnSafetyFactor
+			}
+			inDanger = inDanger || world.DistSq(x, y, cell.X, cell.Y) <= (radius+safeDist)*(radius+safeDist)
+		}
+	})
 
-		if !isTooClose(x, y, radius, playersToAvoid, getPlayerPosition, getPlayerRadius) &&
-			!isTooClose(x, y, radius, sporesToAvoid, getSporePosition, getSporeRadius) {
+	return inDanger
+}
+
+// Pick a spot inside one of the given regions of the world (or anywhere if there are none) for an
+// object with the given radius, away from the given objects if possible. The world can't grow, so
+// if it's too crowded to find a clear spot the last one tried is used regardless.
+func SpawnCoords(radius float64, world *World, regions []Region, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore], hazardsToAvoid *SharedCollection[*Hazard]) (float64, float64) {
+	const maxTries int = 100
+
+	var x, y float64
+	for tries := 0; tries < maxTries; tries++ {
+		x, y = world.RandomPointIn(regions)
+		x, y = world.Confine(x, y, radius)
+
+		if !isTooClose(world, x, y, radius, playersToAvoid, getPlayerPosition, getPlayerRadius) &&
+			!isTooClose(world, x, y, radius, sporesToAvoid, getSporePosition, getSporeRadius) &&
+			!isTooClose(world, x, y, radius, hazardsToAvoid, getHazardPosition, getHazardRadius) {
 			return x, y
 		}
+	}
 
-		tries++
-		if tries >= maxTries {
-			bound *= 2
-			tries = 0
+	return x, y
+}
+
+// Pick a spot for a player with the given radius like SpawnCoords, but also at a safe distance from
+// larger players if possible, so they aren't eaten the moment they appear. If it's too crowded to
+// find a clear spot, the last safe spot tried is used regardless, or failing that the last spot tried.
+func PlayerSpawnCoords(radius float64, world *World, regions []Region, players *SharedCollection[*Player], hazardsToAvoid *SharedCollection[*Hazard]) (float64, float64) {
+	const maxTries int = 100
+
+	var x, y, safeX, safeY float64
+	foundSafe := false
+	for tries := 0; tries < maxTries; tries++ {
+		x, y = world.RandomPointIn(regions)
+		x, y = world.Confine(x, y, radius)
+
+		if isInDanger(world, x, y, radius, players) {
+			continue
+		}
+		safeX, safeY, foundSafe = x, y, true
+
+		if !isTooClose(world, x, y, radius, hazardsToAvoid, getHazardPosition, getHazardRadius) {
+			return x, y
 		}
 	}
 
+	if foundSafe {
+		return safeX, safeY
+	}
+	return x, y
 }
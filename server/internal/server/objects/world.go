@@ -0,0 +1,218 @@
+package objects
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// The shape of a world's boundary, which decides what happens to objects reaching its edge
+type WorldShape int
+
+const (
+	// A square world whose edges objects can't move past
+	WorldRect WorldShape = iota
+
+	// A round world whose edge objects can't move past
+	WorldCircle
+
+	// A square world where objects crossing one edge come back in from the opposite one
+	WorldTorus
+)
+
+var worldShapeNames = map[WorldShape]string{
+	WorldRect:   "rect",
+	WorldCircle: "circle",
+	WorldTorus:  "torus",
+}
+
+func (s WorldShape) String() string {
+	return worldShapeNames[s]
+}
+
+// Parse the shape from its name, e.g. in a map file
+func (s *WorldShape) UnmarshalText(text []byte) error {
+	for shape, name := range worldShapeNames {
+		if name == string(text) {
+			*s = shape
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown world shape %q", text)
+}
+
+// An axis-aligned rectangle of the world, starting at its top-left corner
+type Region struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+
+	// How likely the region is to be picked compared to the others in the same list, where 0 counts as 1
+	Weight float64 `json:"weight,omitempty"`
+}
+
+func (r Region) weight() float64 {
+	if r.Weight <= 0 {
+		return 1
+	}
+	return r.Weight
+}
+
+// Returns the point of the region closest to the given point, which is the point itself if it's inside
+func (r Region) closestPoint(x, y float64) (float64, float64) {
+	return max(r.X, min(x, r.X+r.Width)), max(r.Y, min(y, r.Y+r.Height))
+}
+
+// Contains reports whether the point is inside the region
+func (r Region) Contains(x, y float64) bool {
+	return x >= r.X && x <= r.X+r.Width && y >= r.Y && y <= r.Y+r.Height
+}
+
+type World struct {
+	Shape WorldShape `json:"shape"`
+
+	// Half the width of a square world, or the radius of a round one, centred on the origin
+	Size float64 `json:"size"`
+
+	// Solid areas nothing can move through
+	Obstacles []Region `json:"obstacles,omitempty"`
+
+	// Where players are spawned, or anywhere if there are none
+	SpawnZones []Region `json:"spawn_zones,omitempty"`
+
+	// Where spores grow, weighted by how dense they should be, or anywhere if there are none
+	SporeRegions []Region `json:"spore_regions,omitempty"`
+
+	// Areas players score points for holding
+	ControlZones []Region `json:"control_zones,omitempty"`
+}
+
+// Encloses reports whether the region lies entirely inside the world
+func (w *World) Encloses(r Region) bool {
+	if r.X < -w.Size || r.Y < -w.Size || r.X+r.Width > w.Size || r.Y+r.Height > w.Size {
+		return false
+	}
+	if w.Shape != WorldCircle {
+		return true
+	}
+
+	// A rectangle is inside a circle centred on the origin if its furthest corner is
+	farX := max(math.Abs(r.X), math.Abs(r.X+r.Width))
+	farY := max(math.Abs(r.Y), math.Abs(r.Y+r.Height))
+	return math.Hypot(farX, farY) <= w.Size
+}
+
+// RandomPointIn returns a point picked uniformly from one of the given regions, chosen by weight, or
+// from anywhere in the world if there are no regions
+func (w *World) RandomPointIn(regions []Region) (float64, float64) {
+	totalWeight := 0.0
+	for _, region := range regions {
+		totalWeight += region.weight()
+	}
+
+	pick := rand.Float64() * totalWeight
+	for _, region := range regions {
+		if pick -= region.weight(); pick <= 0 {
+			return region.X + rand.Float64()*region.Width, region.Y + rand.Float64()*region.Height
+		}
+	}
+
+	return w.RandomPoint()
+}
+
+// RandomPoint returns a point picked uniformly from inside the world
+func (w *World) RandomPoint() (float64, float64) {
+	if w.Shape == WorldCircle {
+		angle := 2 * math.Pi * rand.Float64()
+		dist := w.Size * math.Sqrt(rand.Float64())
+		return dist * math.Cos(angle), dist * math.Sin(angle)
+	}
+
+	return w.Size * (2*rand.Float64() - 1), w.Size * (2*rand.Float64() - 1)
+}
+
+// Confine returns the closest position to the given one that keeps an object with the given radius
+// inside the world and out of its obstacles. In a torus, positions past an edge are wrapped around
+// to the opposite one instead.
+func (w *World) Confine(x, y, radius float64) (float64, float64) {
+	for _, obstacle := range w.Obstacles {
+		x, y = pushOut(obstacle, x, y, radius)
+	}
+	return w.confineToBounds(x, y, radius)
+}
+
+func (w *World) confineToBounds(x, y, radius float64) (float64, float64) {
+	switch w.Shape {
+	case WorldCircle:
+		limit := max(w.Size-radius, 0)
+		if dist := math.Hypot(x, y); dist > limit {
+			return x * limit / dist, y * limit / dist
+		}
+		return x, y
+	case WorldTorus:
+		return w.wrap(x), w.wrap(y)
+	default:
+		limit := max(w.Size-radius, 0)
+		return max(-limit, min(x, limit)), max(-limit, min(y, limit))
+	}
+}
+
+// Delta returns the shortest vector from the first position to the second, which in a torus may
+// cross an edge of the world
+func (w *World) Delta(fromX, fromY, toX, toY float64) (float64, float64) {
+	dx := toX - fromX
+	dy := toY - fromY
+	if w.Shape == WorldTorus {
+		dx, dy = w.wrap(dx), w.wrap(dy)
+	}
+	return dx, dy
+}
+
+// DistSq returns the squared shortest distance between the two positions
+func (w *World) DistSq(x1, y1, x2, y2 float64) float64 {
+	dx, dy := w.Delta(x1, y1, x2, y2)
+	return dx*dx + dy*dy
+}
+
+// Dist returns the shortest distance between the two positions
+func (w *World) Dist(x1, y1, x2, y2 float64) float64 {
+	return math.Sqrt(w.DistSq(x1, y1, x2, y2))
+}
+
+// Returns the closest position to the given one that keeps a circle with the given radius from
+// overlapping the obstacle
+func pushOut(obstacle Region, x, y, radius float64) (float64, float64) {
+	closestX, closestY := obstacle.closestPoint(x, y)
+	dx, dy := x-closestX, y-closestY
+	dist := math.Hypot(dx, dy)
+
+	if dist >= radius {
+		return x, y
+	}
+	if dist > 0 {
+		return closestX + dx/dist*radius, closestY + dy/dist*radius
+	}
+
+	// The centre is inside the obstacle, so take the shortest way out through one of its edges
+	toLeft := x - obstacle.X
+	toRight := obstacle.X + obstacle.Width - x
+	toTop := y - obstacle.Y
+	toBottom := obstacle.Y + obstacle.Height - y
+	switch min(toLeft, toRight, toTop, toBottom) {
+	case toLeft:
+		return obstacle.X - radius, y
+	case toRight:
+		return obstacle.X + obstacle.Width + radius, y
+	case toTop:
+		return x, obstacle.Y - radius
+	default:
+		return x, obstacle.Y + obstacle.Height + radius
+	}
+}
+
+// Wrap a coordinate into the range [-Size, Size)
+func (w *World) wrap(v float64) float64 {
+	width := 2 * w.Size
+	return v - width*math.Floor((v+w.Size)/width)
+}
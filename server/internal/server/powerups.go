@@ -0,0 +1,86 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"server/internal/server/objects"
+)
+
+// How a kind of power-up behaves, so designers can tweak power-ups without touching the code
+type PowerUpEffect struct {
+	Kind string `json:"kind"`
+
+	// How long the effect lasts after the power-up is collected, in seconds
+	Duration float64 `json:"duration"`
+
+	// What strength means depends on the kind: the speed multiplier, the magnet's reach, or the
+	// mass gain multiplier. Shields are either on or off.
+	Strength float64 `json:"strength"`
+
+	// How often this kind spawns compared to the others
+	Weight float64 `json:"weight"`
+}
+
+// The power-ups arenas spawn unless a catalog file says otherwise
+var DefaultPowerUps = []PowerUpEffect{
+	{Kind: objects.PowerUpSpeed, Duration: 8, Strength: 1.5, Weight: 3},
+	{Kind: objects.PowerUpShield, Duration: 6, Weight: 2},
+	{Kind: objects.PowerUpMagnet, Duration: 10, Strength: 250, Weight: 2},
+	{Kind: objects.PowerUpDoubleMass, Duration: 10, Strength: 2, Weight: 1},
+}
+
+func (e PowerUpEffect) validate() error {
+	switch e.Kind {
+	case objects.PowerUpSpeed, objects.PowerUpShield, objects.PowerUpMagnet, objects.PowerUpDoubleMass:
+	default:
+		return fmt.Errorf("unknown power-up kind %q", e.Kind)
+	}
+
+	if e.Duration <= 0 || e.Weight <= 0 {
+		return fmt.Errorf("power-up %s needs a positive duration and weight", e.Kind)
+	}
+
+	// Multipliers below 1 would make collecting the power-up a punishment, and at 0 they'd freeze
+	// the player or stop them growing altogether
+	switch e.Kind {
+	case objects.PowerUpSpeed, objects.PowerUpDoubleMass:
+		if e.Strength < 1 {
+			return fmt.Errorf("power-up %s needs a strength of at least 1", e.Kind)
+		}
+	case objects.PowerUpMagnet:
+		if e.Strength <= 0 {
+			return fmt.Errorf("power-up %s needs a positive strength", e.Kind)
+		}
+	}
+	return nil
+}
+
+// Load the power-up catalog from the given JSON file, falling back to the defaults if the file
+// doesn't exist or isn't valid
+func loadPowerUps(filePath string) []PowerUpEffect {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Error reading power-ups %s, using the defaults: %v", filePath, err)
+		}
+		return DefaultPowerUps
+	}
+
+	var catalog []PowerUpEffect
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		log.Printf("Error parsing power-ups %s, using the defaults: %v", filePath, err)
+		return DefaultPowerUps
+	}
+
+	for _, effect := range catalog {
+		if err := effect.validate(); err != nil {
+			log.Printf("Invalid power-ups %s, using the defaults: %v", filePath, err)
+			return DefaultPowerUps
+		}
+	}
+
+	log.Printf("Loaded %d power-ups from %s", len(catalog), filePath)
+	return catalog
+}
@@ -0,0 +1,168 @@
+// Package servertest provides an in-memory client transport and helpers for
+// driving client state handlers from ordinary Go tests, without a network
+// connection or a hand-managed database file.
+package servertest
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/server/states"
+	"server/pkg/packets"
+	"sync"
+	"testing"
+	"time"
+)
+
+// The default amount of time to wait for an expected packet before failing
+const DefaultTimeout = 2 * time.Second
+
+// Adds files to the data directory of a hub created by NewHub before the hub
+// loads them
+type HubOption func(t testing.TB, dataDirPath string)
+
+// NewHub creates a hub backed by a fresh SQLite database in a temporary
+// directory, and starts running it in the background.
+func NewHub(t testing.TB, options ...HubOption) *server.Hub {
+	t.Helper()
+
+	dataDirPath := t.TempDir()
+	for _, option := range options {
+		option(t, dataDirPath)
+	}
+	hub := server.NewHub(dataDirPath)
+	go hub.Run()
+
+	return hub
+}
+
+// WithMap adds a map with the given JSON definition to the hub's maps
+// directory. A map named "default" lays out the hub's public arenas, and any
+// other can be picked when creating a private arena.
+func WithMap(name string, definition string) HubOption {
+	return func(t testing.TB, dataDirPath string) {
+		t.Helper()
+
+		mapsDirPath := filepath.Join(dataDirPath, "maps")
+		if err := os.MkdirAll(mapsDirPath, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(mapsDirPath, name+".json"), []byte(definition), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// WithoutHazards lays the hub's public arenas out without hazards, for tests
+// that don't want players bursting on whichever hazards happen to be in their way
+func WithoutHazards() HubOption {
+	return WithMap("default", fmt.Sprintf(`{"name": "default", "size": %g, "max_hazards": 0}`, server.DefaultWorldSize))
+}
+
+// An in-memory implementation of server.ClientInterfacer which records every
+// packet that would have been written to the socket
+type Client struct {
+	*server.ClientBase
+
+	// Packets that would have been sent to the socket, in order
+	sendChan chan *packets.Packet
+
+	// Serializes state changes and message processing, like the read pump does
+	pumpMux sync.Mutex
+	closed  bool
+}
+
+// NewClient creates a client for the given hub without registering it
+func NewClient(hub *server.Hub) *Client {
+	c := &Client{
+		sendChan: make(chan *packets.Packet, 4096),
+	}
+	c.ClientBase = server.NewClientBase(c, hub, log.New(log.Writer(), "Test client unknown: ", log.LstdFlags))
+	return c
+}
+
+// Connect creates a client, registers it with the hub and waits for the ID
+// message the Connected state sends on entry.
+func Connect(t testing.TB, hub *server.Hub) *Client {
+	t.Helper()
+
+	c := NewClient(hub)
+	hub.RegisterChan <- c
+	Expect[*packets.Packet_Id](t, c)
+
+	t.Cleanup(func() { c.Close("test finished") })
+
+	return c
+}
+
+func (c *Client) Initialize(id uint64) {
+	c.SetId(id, fmt.Sprintf("Test client %d: ", id))
+	c.SetState(&states.Connected{})
+}
+
+func (c *Client) SocketSendAs(message packets.Msg, senderId uint64) {
+	select {
+	case c.sendChan <- &packets.Packet{SenderId: senderId, Msg: message}:
+	default:
+		c.Logger().Printf("Send channel full, dropping message: %T", message)
+	}
+}
+
+// There is no socket to read from, use Send to feed messages to the client instead
+func (c *Client) ReadPump() {}
+
+// There is no socket to write to, use Expect to inspect what was sent instead
+func (c *Client) WritePump() {}
+
+func (c *Client) Close(reason string) {
+	c.pumpMux.Lock()
+	defer c.pumpMux.Unlock()
+
+	if c.closed {
+		return
+	}
+	c.closed = true
+
+	c.Shutdown(reason)
+}
+
+// Send processes the given messages in order, as if they had been read from
+// the client's socket
+func (c *Client) Send(messages ...packets.Msg) {
+	c.SendAs(c.Id(), messages...)
+}
+
+// SendAs processes the given messages in order, as if they had been read from
+// the client's socket with the given sender ID
+func (c *Client) SendAs(senderId uint64, messages ...packets.Msg) {
+	c.pumpMux.Lock()
+	defer c.pumpMux.Unlock()
+
+	for _, message := range messages {
+		c.ProcessMessage(senderId, message)
+	}
+}
+
+// Next waits for the next packet sent to the client's socket and returns it, or
+// returns false if nothing was sent within the timeout
+func (c *Client) Next(timeout time.Duration) (*packets.Packet, bool) {
+	select {
+	case packet := <-c.sendChan:
+		return packet, true
+	case <-time.After(timeout):
+		return nil, false
+	}
+}
+
+// Drain discards every packet sent to the client's socket so far
+func (c *Client) Drain() {
+	for {
+		select {
+		case <-c.sendChan:
+		default:
+			return
+		}
+	}
+}
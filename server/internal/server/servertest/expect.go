@@ -0,0 +1,170 @@
+package servertest
+
+import (
+	"server/internal/server"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+// Expect waits for the next packet of type T sent to the client's socket,
+// skipping any packets of other types, and fails the test if none arrives
+// within DefaultTimeout.
+func Expect[T packets.Msg](t testing.TB, c *Client) T {
+	t.Helper()
+	msg, _ := ExpectFrom[T](t, c, DefaultTimeout)
+	return msg
+}
+
+// ExpectFrom is like Expect, but with a custom timeout, and also returns the
+// sender ID of the packet.
+func ExpectFrom[T packets.Msg](t testing.TB, c *Client, timeout time.Duration) (T, uint64) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		packet, ok := c.Next(time.Until(deadline))
+		if !ok {
+			var zero T
+			t.Fatalf("Timed out after %v waiting for %T", timeout, zero)
+			return zero, 0
+		}
+
+		if msg, ok := packet.Msg.(T); ok {
+			return msg, packet.SenderId
+		}
+	}
+}
+
+// ExpectNone fails the test if a packet of type T is sent to the client's
+// socket within the given duration. Packets of other types are discarded.
+func ExpectNone[T packets.Msg](t testing.TB, c *Client, duration time.Duration) {
+	t.Helper()
+
+	deadline := time.Now().Add(duration)
+	for {
+		packet, ok := c.Next(time.Until(deadline))
+		if !ok {
+			return
+		}
+
+		if _, ok := packet.Msg.(T); ok {
+			t.Fatalf("Received unexpected %T from sender %d", packet.Msg, packet.SenderId)
+			return
+		}
+	}
+}
+
+// ExpectOk waits for the next OK or deny response, and fails the test if it
+// was a deny.
+func ExpectOk(t testing.TB, c *Client) {
+	t.Helper()
+
+	deadline := time.Now().Add(DefaultTimeout)
+	for {
+		packet, ok := c.Next(time.Until(deadline))
+		if !ok {
+			t.Fatalf("Timed out after %v waiting for an OK response", DefaultTimeout)
+			return
+		}
+
+		switch msg := packet.Msg.(type) {
+		case *packets.Packet_OkResponse:
+			return
+		case *packets.Packet_DenyResponse:
+			t.Fatalf("Expected an OK response, got denied: %s", msg.DenyResponse.Reason)
+			return
+		}
+	}
+}
+
+// ExpectDeny waits for the next OK or deny response, fails the test if it was
+// an OK, and otherwise returns the reason for the deny.
+func ExpectDeny(t testing.TB, c *Client) string {
+	t.Helper()
+
+	deadline := time.Now().Add(DefaultTimeout)
+	for {
+		packet, ok := c.Next(time.Until(deadline))
+		if !ok {
+			t.Fatalf("Timed out after %v waiting for a deny response", DefaultTimeout)
+			return ""
+		}
+
+		switch msg := packet.Msg.(type) {
+		case *packets.Packet_OkResponse:
+			t.Fatal("Expected a deny response, got OK")
+			return ""
+		case *packets.Packet_DenyResponse:
+			return msg.DenyResponse.Reason
+		}
+	}
+}
+
+// WaitFor polls the condition until it holds, and fails the test if it doesn't
+// within DefaultTimeout. Useful for state that is updated in the background.
+func WaitFor(t testing.TB, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(DefaultTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out after %v waiting for condition", DefaultTimeout)
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Register scripts a successful registration of a new user with the given
+// username, using the username as the password.
+func Register(t testing.TB, c *Client, username string) {
+	t.Helper()
+
+	c.Send(&packets.Packet_RegisterRequest{
+		RegisterRequest: &packets.RegisterRequestMessage{
+			Username: username,
+			Password: username,
+			Color:    0x00ff00ff,
+		},
+	})
+	ExpectOk(t, c)
+}
+
+// Login scripts a successful login of a user previously created with Register
+// and waits for the player to appear in game.
+func Login(t testing.TB, c *Client, username string) *packets.PlayerMessage {
+	t.Helper()
+
+	c.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{
+			Username: username,
+			Password: username,
+		},
+	})
+	ExpectOk(t, c)
+
+	return Expect[*packets.Packet_Player](t, c).Player
+}
+
+// Join connects a new client, then registers and logs in a user with the
+// given username. Returns the client along with its player's initial state.
+func Join(t testing.TB, hub *server.Hub, username string) (*Client, *packets.PlayerMessage) {
+	t.Helper()
+
+	c := Connect(t, hub)
+	Register(t, c, username)
+	return c, Login(t, c, username)
+}
+
+// Host joins a new user with the given username like Join, then takes them
+// back to the lobby, where a logged-in player can create private arenas.
+func Host(t testing.TB, hub *server.Hub, username string) *Client {
+	t.Helper()
+
+	c, _ := Join(t, hub, username)
+	c.Send(packets.NewDisconnect("back to the lobby"))
+	Expect[*packets.Packet_Id](t, c)
+	c.Drain()
+	return c
+}
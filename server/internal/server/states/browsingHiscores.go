@@ -9,7 +9,18 @@ import (
 	"server/pkg/packets"
 )
 
+// The leaderboards players can browse, ranked by best mass or by points scored holding control zones
+const (
+	hiscoreCategoryMass  = "mass"
+	hiscoreCategoryZones = "zones"
+)
+
 type BrowsingHiscores struct {
+	// The leaderboard being browsed, where empty means the mass one
+	category string
+	// The ID of the player logged in on this connection, to carry back to the lobby
+	playerId int64
+
 	client  server.ClientInterfacer
 	logger  *log.Logger
 	queries *db.Queries
@@ -38,6 +49,8 @@ func (b *BrowsingHiscores) HandleMessage(senderId uint64, message packets.Msg) {
 		b.handleFinishedBrowsingHiscoresMessage(senderId, message)
 	case *packets.Packet_SearchHiscore:
 		b.handleSearchHiscore(senderId, message)
+	case *packets.Packet_HiscoreBoardRequest:
+		b.handleHiscoreBoardRequest(senderId, message)
 	}
 }
 
@@ -45,7 +58,13 @@ func (b *BrowsingHiscores) OnExit() {
 }
 
 func (b *BrowsingHiscores) handleFinishedBrowsingHiscoresMessage(senderId uint64, message *packets.Packet_FinishedBrowsingHiscores) {
-	b.client.SetState(&Connected{})
+	b.client.SetState(&Connected{playerId: b.playerId})
+}
+
+// Switch to another leaderboard
+func (b *BrowsingHiscores) handleHiscoreBoardRequest(senderId uint64, message *packets.Packet_HiscoreBoardRequest) {
+	b.category = message.HiscoreBoardRequest.Category
+	b.sendTopScores(10, 0)
 }
 
 func (b *BrowsingHiscores) handleSearchHiscore(senderId uint64, message *packets.Packet_SearchHiscore) {
@@ -57,7 +76,12 @@ func (b *BrowsingHiscores) handleSearchHiscore(senderId uint64, message *packets
 		return
 	}
 
-	playerRank, err := b.queries.GetPlayerRank(b.dbCtx, player.ID)
+	var playerRank int64
+	if b.category == hiscoreCategoryZones {
+		playerRank, err = b.queries.GetPlayerZoneRank(b.dbCtx, player.ID)
+	} else {
+		playerRank, err = b.queries.GetPlayerRank(b.dbCtx, player.ID)
+	}
 	if err != nil {
 		b.logger.Printf("Error getting rank of player %s: %v", player.Name, err)
 		b.client.SocketSend(packets.NewDenyResponse("Player is unranked"))
@@ -70,25 +94,42 @@ func (b *BrowsingHiscores) handleSearchHiscore(senderId uint64, message *packets
 }
 
 func (b *BrowsingHiscores) sendTopScores(limit, offset int64) {
-	topScores, err := b.queries.GetTopScores(b.dbCtx, db.GetTopScoresParams{
-		Limit:  limit,
-		Offset: offset,
-	})
+	var names []string
+	var scores []int64
+	var err error
+
+	switch b.category {
+	case "", hiscoreCategoryMass:
+		var rows []db.GetTopScoresRow
+		rows, err = b.queries.GetTopScores(b.dbCtx, db.GetTopScoresParams{Limit: limit, Offset: offset})
+		for _, row := range rows {
+			names, scores = append(names, row.Name), append(scores, row.BestScore)
+		}
+	case hiscoreCategoryZones:
+		var rows []db.GetTopZoneScoresRow
+		rows, err = b.queries.GetTopZoneScores(b.dbCtx, db.GetTopZoneScoresParams{Limit: limit, Offset: offset})
+		for _, row := range rows {
+			names, scores = append(names, row.Name), append(scores, row.BestScore)
+		}
+	default:
+		b.client.SocketSend(packets.NewDenyResponse("No such leaderboard"))
+		return
+	}
 	if err != nil {
-		b.logger.Printf("Error getting top %d scores from rank %d: %v", limit, offset, err)
+		b.logger.Printf("Error getting top %d %s scores from rank %d: %v", limit, b.category, offset, err)
 		b.client.SocketSend(packets.NewDenyResponse("Failed to get top scores - please try again later"))
 		return
 	}
 
 	hiscoreMessages := make([]*packets.HiscoreMessage, 0, limit)
-	for rank, scoreRow := range topScores {
+	for rank, name := range names {
 		hiscoreMessage := &packets.HiscoreMessage{
 			Rank:  uint64(rank) + uint64(offset) + 1,
-			Name:  scoreRow.Name,
-			Score: uint64(scoreRow.BestScore),
+			Name:  name,
+			Score: uint64(scores[rank]),
 		}
 		hiscoreMessages = append(hiscoreMessages, hiscoreMessage)
 	}
 
-	b.client.SocketSend(packets.NewHiscoreBoard(hiscoreMessages))
+	b.client.SocketSend(packets.NewHiscoreBoard(hiscoreMessages, b.category))
 }
@@ -1,14 +1,17 @@
 package states
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"server/internal/server"
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
+	"slices"
 	"strings"
 
 	"golang.org/x/crypto/bcrypt"
@@ -19,6 +22,9 @@ type Connected struct {
 	logger  *log.Logger
 	queries *db.Queries
 	dbCtx   context.Context
+
+	// The ID of the player this connection has logged in as, or 0 if it hasn't yet
+	playerId int64
 }
 
 func (c *Connected) Name() string {
@@ -34,6 +40,8 @@ func (c *Connected) SetClient(client server.ClientInterfacer) {
 }
 
 func (c *Connected) OnEnter() {
+	// Back in the lobby, so the client is no longer playing in any arena
+	c.client.LeaveArena()
 	c.client.SocketSend(packets.NewId(c.client.Id()))
 }
 
@@ -45,6 +53,12 @@ func (c *Connected) HandleMessage(senderId uint64, message packets.Msg) {
 		c.handleRegisterRequest(senderId, message)
 	case *packets.Packet_HiscoreBoardRequest:
 		c.handleHiscoreBoardRequest(senderId, message)
+	case *packets.Packet_ArenaListRequest:
+		c.handleArenaListRequest(senderId, message)
+	case *packets.Packet_CreateArenaRequest:
+		c.handleCreateArenaRequest(senderId, message)
+	case *packets.Packet_SpectateRequest:
+		c.handleSpectateRequest(senderId, message)
 	}
 }
 
@@ -59,26 +73,21 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 
 	username := message.LoginRequest.Username
 
-	genericFailMessage := packets.NewDenyResponse("Incorrect username or password")
-
-	user, err := c.queries.GetUserByUsername(c.dbCtx, strings.ToLower(username))
+	player, err := c.authenticate(username, message.LoginRequest.Password)
 	if err != nil {
-		c.logger.Printf("Error getting user by username: %v", err)
-		c.client.SocketSend(genericFailMessage)
+		c.client.SocketSend(packets.NewDenyResponse("Incorrect username or password"))
 		return
 	}
+	c.playerId = player.ID
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(message.LoginRequest.Password))
-	if err != nil {
-		c.logger.Printf("Incorrect password for user %s", username)
-		c.client.SocketSend(genericFailMessage)
-		return
+	if inviteCode := message.LoginRequest.InviteCode; inviteCode != "" {
+		err = c.client.JoinPrivateArena(inviteCode, player.ID)
+	} else {
+		err = c.client.JoinArena(message.LoginRequest.ArenaId)
 	}
-
-	player, err := c.queries.GetPlayerByUserId(c.dbCtx, user.ID)
 	if err != nil {
-		c.logger.Printf("Error getting player for user %s: %v", username, err)
-		c.client.SocketSend(genericFailMessage)
+		c.logger.Printf("User %s could not join arena: %v", username, err)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Could not join arena: %v", err)))
 		return
 	}
 
@@ -155,7 +164,103 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 }
 
 func (c *Connected) handleHiscoreBoardRequest(senderId uint64, message *packets.Packet_HiscoreBoardRequest) {
-	c.client.SetState(&BrowsingHiscores{})
+	c.client.SetState(&BrowsingHiscores{playerId: c.playerId, category: message.HiscoreBoardRequest.Category})
+}
+
+func (c *Connected) handleArenaListRequest(senderId uint64, message *packets.Packet_ArenaListRequest) {
+	arenaMessages := make([]*packets.ArenaMessage, 0, c.client.Arenas().Len())
+	c.client.Arenas().ForEach(func(arenaId uint64, arena *server.Arena) {
+		if arena.Private {
+			return
+		}
+		arenaMessages = append(arenaMessages, &packets.ArenaMessage{
+			Id:         arenaId,
+			Players:    uint64(arena.Clients.Len()),
+			MaxPlayers: uint64(arena.MaxPlayers),
+			Spectators: uint64(arena.Spectators.Len()),
+			MapName:    arena.MapName,
+			Mode:       arena.Mode.Name(),
+		})
+	})
+
+	slices.SortFunc(arenaMessages, func(a, b *packets.ArenaMessage) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	c.client.SocketSend(packets.NewArenaList(arenaMessages))
+}
+
+func (c *Connected) handleCreateArenaRequest(senderId uint64, message *packets.Packet_CreateArenaRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received create arena request from another client (Id %d)", senderId)
+		return
+	}
+
+	// Only players who have logged in can host, so that there's someone to hold to the limit on arenas
+	if c.playerId == 0 {
+		c.client.SocketSend(packets.NewDenyResponse("Log in to host an arena"))
+		return
+	}
+
+	arena, err := c.client.CreatePrivateArena(c.playerId, server.ArenaSettings{
+		MapName:        message.CreateArenaRequest.MapName,
+		WorldSize:      message.CreateArenaRequest.WorldSize,
+		WorldShape:     objects.WorldShape(message.CreateArenaRequest.WorldShape),
+		MaxSpores:      int(min(message.CreateArenaRequest.MaxSpores, math.MaxInt32)),
+		MaxPlayers:     int(min(message.CreateArenaRequest.MaxPlayers, math.MaxInt32)),
+		RecordHiscores: message.CreateArenaRequest.RecordHiscores,
+		Mode:           message.CreateArenaRequest.Mode,
+	})
+	if err != nil {
+		c.logger.Printf("Failed to create private arena: %v", err)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Could not create arena: %v", err)))
+		return
+	}
+
+	c.logger.Printf("Created private arena %d with invite code %s", arena.Id, arena.InviteCode)
+	c.client.SocketSend(packets.NewArenaCreated(arena.Id, arena.InviteCode))
+}
+
+func (c *Connected) handleSpectateRequest(senderId uint64, message *packets.Packet_SpectateRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received spectate request from another client (Id %d)", senderId)
+		return
+	}
+
+	err := c.client.SpectateArena(message.SpectateRequest.ArenaId, message.SpectateRequest.InviteCode, c.playerId)
+	if err != nil {
+		c.logger.Printf("Could not spectate arena: %v", err)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Could not spectate arena: %v", err)))
+		return
+	}
+
+	c.client.SocketSend(packets.NewOkResponse())
+	c.client.SetState(&Spectating{playerId: c.playerId, followingId: message.SpectateRequest.PlayerId})
+}
+
+// Look up the player whose account the credentials are for, logging why if they don't check out
+func (c *Connected) authenticate(username string, password string) (db.Player, error) {
+	genericErr := errors.New("incorrect username or password")
+
+	user, err := c.queries.GetUserByUsername(c.dbCtx, strings.ToLower(username))
+	if err != nil {
+		c.logger.Printf("Error getting user by username: %v", err)
+		return db.Player{}, genericErr
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
+	if err != nil {
+		c.logger.Printf("Incorrect password for user %s", username)
+		return db.Player{}, genericErr
+	}
+
+	player, err := c.queries.GetPlayerByUserId(c.dbCtx, user.ID)
+	if err != nil {
+		c.logger.Printf("Error getting player for user %s: %v", username, err)
+		return db.Player{}, genericErr
+	}
+
+	return player, nil
 }
 
 func validateUsername(username string) error {
@@ -0,0 +1,127 @@
+package states
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+type Dead struct {
+	client  server.ClientInterfacer
+	arena   *server.Arena
+	logger  *log.Logger
+	queries *db.Queries
+	dbCtx   context.Context
+
+	// The player as they were at the moment they were consumed
+	player     *objects.Player
+	killerId   uint64
+	killerName string
+}
+
+func (d *Dead) Name() string {
+	return "Dead"
+}
+
+func (d *Dead) SetClient(client server.ClientInterfacer) {
+	d.client = client
+	d.arena = client.Arena()
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), d.Name())
+	d.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	d.queries = client.DbTx().Queries
+	d.dbCtx = client.DbTx().Ctx
+}
+
+func (d *Dead) OnEnter() {
+	d.client.SocketSend(packets.NewDeathSummary(d.killerId, d.killerName, d.player, time.Now()))
+}
+
+func (d *Dead) HandleMessage(senderId uint64, message packets.Msg) {
+	if senderId == d.client.Id() {
+		switch message := message.(type) {
+		case *packets.Packet_RespawnRequest:
+			d.handleRespawnRequest(senderId, message)
+		case *packets.Packet_SpectateRequest:
+			d.handleSpectateRequest(senderId, message)
+		case *packets.Packet_Chat:
+			if message.Chat.TeamOnly && d.player.Team == 0 {
+				d.client.SocketSend(packets.NewDenyResponse("You're not on a team"))
+				return
+			}
+			message.Chat.Team = d.player.Team
+			d.client.Broadcast(message)
+		case *packets.Packet_KickPlayer:
+			handleKick(d.client, d.arena, d.logger, senderId, message)
+		case *packets.Packet_Disconnect:
+			d.client.SetState(&Connected{playerId: d.player.DbId})
+		}
+		return
+	}
+
+	// Keep the world going behind the death screen, so it's up to date when we respawn
+	switch message := message.(type) {
+	case *packets.Packet_Player, *packets.Packet_Spore, *packets.Packet_SporeConsumed, *packets.Packet_CellRemoved,
+		*packets.Packet_Hazard, *packets.Packet_HazardConsumed, *packets.Packet_PowerUp, *packets.Packet_PowerUpCollected,
+		*packets.Packet_GameMode, *packets.Packet_SafeZone, *packets.Packet_RoundOver, *packets.Packet_TeamScores,
+		*packets.Packet_Zone, *packets.Packet_KillFeed, *packets.Packet_Announcement, *packets.Packet_SporesExpired,
+		*packets.Packet_PlayerConsumed, *packets.Packet_Disconnect:
+		d.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Chat:
+		if canSeeChat(message.Chat, d.player.Team) {
+			d.client.SocketSendAs(message, senderId)
+		}
+	case *packets.Packet_KickPlayer:
+		if handleKick(d.client, d.arena, d.logger, senderId, message) {
+			d.client.SetState(&Connected{playerId: d.player.DbId})
+		}
+	}
+}
+
+func (d *Dead) OnExit() {
+}
+
+func (d *Dead) handleRespawnRequest(senderId uint64, message *packets.Packet_RespawnRequest) {
+	if d.arena.IsKicked(d.player.DbId) {
+		d.client.SocketSend(packets.NewDenyResponse("You have been kicked from this arena"))
+		return
+	}
+
+	// Rebuild the player from the account, rather than trusting what was left of them in game
+	player, err := d.queries.GetPlayerById(d.dbCtx, d.player.DbId)
+	if err != nil {
+		d.logger.Printf("Error getting player %d to respawn: %v", d.player.DbId, err)
+		d.client.SocketSend(packets.NewDenyResponse("Failed to respawn - please try again later"))
+		return
+	}
+
+	d.logger.Printf("Player %s respawning", player.Name)
+	d.client.SetState(&InGame{
+		player: &objects.Player{
+			Name:      player.Name,
+			DbId:      player.ID,
+			BestScore: player.BestScore,
+			Color:     int32(player.Color),
+		},
+	})
+}
+
+func (d *Dead) handleSpectateRequest(senderId uint64, message *packets.Packet_SpectateRequest) {
+	if err := d.client.SpectateArena(d.arena.Id, d.arena.InviteCode, d.player.DbId); err != nil {
+		d.logger.Printf("Failed to spectate arena %d: %v", d.arena.Id, err)
+		d.client.SocketSend(packets.NewDenyResponse("Could not spectate this arena"))
+		return
+	}
+
+	// Watch our killer unless we asked to follow someone else
+	followingId := message.SpectateRequest.PlayerId
+	if followingId == 0 {
+		followingId = d.killerId
+	}
+
+	d.client.SetState(&Spectating{playerId: d.player.DbId, followingId: followingId})
+}
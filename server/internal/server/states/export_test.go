@@ -0,0 +1,13 @@
+package states
+
+import "server/internal/server/objects"
+
+// WithPlayer runs the function on the player in between the updates of their loop and the messages
+// handled on their behalf, then publishes where their cells ended up, so tests can look at or set up
+// the player without racing with their own goroutines
+func (g *InGame) WithPlayer(do func(player *objects.Player)) {
+	g.mux.Lock()
+	defer g.unlock()
+
+	do(g.player)
+}
@@ -10,14 +10,120 @@ import (
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
+	"sync"
 	"time"
 )
 
+const (
+	// Cells smaller than this can't be split any further
+	minSplitRadius = 35.0
+
+	// The most cells a player can be split into, including the main body
+	maxCells = 16
+
+	// The speed a new cell is launched with when splitting off
+	splitLaunchSpeed = 600.0
+
+	// How long split cells have to wait before they can merge back into the main body
+	mergeCooldown = 10 * time.Second
+
+	// Cells smaller than this can't eject any mass
+	minEjectRadius = 30.0
+
+	// The size and launch speed of spores ejected by the player, and how often they can be ejected
+	ejectSporeRadius = 12.0
+	ejectLaunchSpeed = 800.0
+	ejectCooldown    = 100 * time.Millisecond
+
+	// Mass ejected towards a teammate within this distance is passed to them directly
+	teamShareRadius = 500.0
+
+	// Cells need to be this many times as massive as a hazard to run into it
+	hazardBurstMassRatio = 1.33
+
+	// The most pieces a cell bursts into when it runs into a hazard
+	maxBurstPieces = 8
+
+	// The share of mass a cell loses to a hazard when the player has no room left to burst
+	hazardDrainFraction = 0.25
+
+	// Hazards further than this from the player aren't sent to the client
+	hazardInterestRadius = 1500.0
+
+	// How much faster boosting makes the player, and the share of their mass it costs per second
+	boostSpeedMultiplier = 1.6
+	boostMassCostRate    = 0.05
+
+	// Players smaller than this can't afford to boost
+	minBoostRadius = 25.0
+
+	// The size of the spores decayed mass is returned to the world as
+	decaySporeRadius = 10.0
+
+	// How fast a magnet pulls spores in
+	magnetPullSpeed = 300.0
+
+	// Poison can't shrink cells any smaller than this
+	minCellRadius = 10.0
+
+	// How long newly spawned players can't be consumed for, unless they eat something first
+	spawnProtection = 3 * time.Second
+
+	// Kill streaks are announced when they reach this length, and every 5 kills after that
+	killStreakAnnouncement = 3
+
+	// How quickly the player's velocity catches up with where they're steering, and how quickly it
+	// dies down once they let go. Cells as massive as steeringHalfMass steer half as quickly as the
+	// smallest ones, and heavier ones slower still.
+	steeringRate     = 8.0
+	steeringHalfMass = 10000.0
+	frictionRate     = 4.0
+
+	// Spores eaten within this long of each other build up a combo, each one adding to the mass
+	// multiplier for the next, up to a limit
+	comboWindow         = 1500 * time.Millisecond
+	comboMultiplierStep = 0.1
+	maxComboMultiplier  = 3.0
+)
+
+// The masses players are announced to have reached as they grow
+var massMilestones = []float64{10000, 25000, 50000, 100000}
+
 type InGame struct {
 	client                 server.ClientInterfacer
+	arena                  *server.Arena
 	player                 *objects.Player
 	logger                 *log.Logger
 	cancelPlayerUpdateLoop context.CancelFunc
+	lastEjectAt            time.Time
+	boosting               bool
+
+	// Serializes the player's own loop with the messages handled on their behalf, which both change
+	// the player. Anything that could wait on another goroutine, like broadcasts, is held back until
+	// the lock is let go of, since the arena may be waiting for us in turn.
+	mux      sync.Mutex
+	heldBack []func()
+
+	// Mass lost to decay that hasn't been returned to the world as a spore yet
+	decayedMass float64
+
+	// The index of the next of the massMilestones the player has yet to reach this life
+	massMilestone int
+
+	// The zone points already recorded as the player's best this life, to avoid saving them again
+	savedZonePoints int64
+
+	// The spores eaten in quick succession and when the last of them was, plus the longest combo
+	// already recorded this life
+	combo       int
+	lastSporeAt time.Time
+	savedCombo  int
+
+	// The hazards the client has been told about, since only the ones nearby are sent
+	visibleHazards *objects.SharedCollection[*objects.Hazard]
+
+	// Signalled when the arena's round is over, for the player's own loop to start them afresh
+	roundOver chan struct{}
 }
 
 func (g *InGame) Name() string {
@@ -26,24 +132,64 @@ func (g *InGame) Name() string {
 
 func (g *InGame) SetClient(client server.ClientInterfacer) {
 	g.client = client
+	g.arena = client.Arena()
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), g.Name())
 	g.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
 }
 
 func (g *InGame) OnEnter() {
-	log.Printf("Adding player %s to the shared collection", g.player.Name)
-	go g.client.SharedGameObjects().Players.Add(g.player, g.client.Id())
+	g.mux.Lock()
+	defer g.unlock()
 
-	// Set the initial properties of the player
-	g.player.X, g.player.Y = objects.SpawnCoords(g.player.Radius, g.client.SharedGameObjects().Players, nil)
-	g.player.Speed = 150.0
-	g.player.Radius = 20.0
+	g.roundOver = make(chan struct{}, 1)
+	g.player.Cells = objects.NewSharedCollection[*objects.Cell]()
+	g.spawn()
 
-	// Send the player's initial state to the client
+	log.Printf("Adding player %s to the shared collection", g.player.Name)
+	go g.arena.SharedGameObjects.Players.Add(g.player, g.client.Id())
+
+	// Send the world's layout and the player's initial state to the client
+	g.client.SocketSend(packets.NewWorld(g.arena.World, g.arena.MapName))
 	g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player))
 
-	// Send the spores to the client in the background
-	go g.sendInitialSpores(20, 50*time.Millisecond)
+	// The state of the arena comes from the arena, like its updates to it
+	for _, msg := range g.arena.Mode.Status() {
+		g.client.SocketSendAs(msg, 0)
+	}
+	for _, msg := range g.arena.ZoneStatus() {
+		g.client.SocketSendAs(msg, 0)
+	}
+
+	// Send the hazards near the player, the rest will follow as the player moves
+	g.visibleHazards = objects.NewSharedCollection[*objects.Hazard]()
+	g.syncHazards()
+
+	// Send the power-ups and spores to the client in the background
+	go sendPowerUps(g.client, g.arena.SharedGameObjects.PowerUps)
+	go sendInitialSpores(g.client, g.arena.SharedGameObjects.Spores, 20, 50*time.Millisecond)
+}
+
+// Set the initial properties of the player, at a fresh spot in the world
+func (g *InGame) spawn() {
+	g.player.Radius = 20.0
+	g.player.X, g.player.Y = objects.PlayerSpawnCoords(g.player.Radius, g.arena.World, g.arena.World.SpawnZones, g.arena.SharedGameObjects.Players, g.arena.SharedGameObjects.Hazards)
+	g.player.Speed = g.arena.SpeedCurve.Speed(radToMass(g.player.Radius))
+	g.player.VelX, g.player.VelY = 0, 0
+	g.player.SpawnedAt = time.Now()
+	g.player.PeakMass = radToMass(g.player.Radius)
+	g.player.SporesEaten = 0
+	g.player.KillStreak = 0
+	g.player.SetBounty(0)
+	g.massMilestone = 0
+	g.player.ZonePoints = 0
+	g.player.TakeZonePoints()
+	g.savedZonePoints = 0
+	g.combo = 0
+	g.savedCombo = 0
+	g.player.SetEffects(nil)
+	g.player.SetProtectedUntil(time.Now().Add(spawnProtection))
+	g.player.PublishPositions()
+	g.arena.Mode.OnSpawn(g.client.Id(), g.player)
 }
 
 func (g *InGame) HandleMessage(senderId uint64, message packets.Msg) {
@@ -62,14 +208,45 @@ func (g *InGame) HandleMessage(senderId uint64, message packets.Msg) {
 		g.handleSpore(senderId, message)
 	case *packets.Packet_Disconnect:
 		g.handleDisconnect(senderId, message)
+	case *packets.Packet_KickPlayer:
+		g.handleKickPlayer(senderId, message)
+	case *packets.Packet_SpectateRequest:
+		g.handleSpectateRequest(senderId, message)
+	case *packets.Packet_Split:
+		g.handleSplit(senderId, message)
+	case *packets.Packet_CellRemoved:
+		g.handleCellRemoved(senderId, message)
+	case *packets.Packet_EjectMass:
+		g.handleEjectMass(senderId, message)
+	case *packets.Packet_Hazard:
+		g.handleHazard(senderId, message)
+	case *packets.Packet_HazardConsumed:
+		g.handleHazardConsumed(senderId, message)
+	case *packets.Packet_Boost:
+		g.handleBoost(senderId, message)
+	case *packets.Packet_PowerUp:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_PowerUpCollected:
+		g.handlePowerUpCollected(senderId, message)
+	case *packets.Packet_GameMode, *packets.Packet_SafeZone, *packets.Packet_TeamScores, *packets.Packet_Zone,
+		*packets.Packet_KillFeed, *packets.Packet_Announcement, *packets.Packet_SporesExpired:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_MassShare:
+		g.handleMassShare(senderId, message)
+	case *packets.Packet_RoundOver:
+		g.handleRoundOver(senderId, message)
 	}
 }
 
 func (g *InGame) OnExit() {
+	g.mux.Lock()
+	defer g.unlock()
+
 	if g.cancelPlayerUpdateLoop != nil {
 		g.cancelPlayerUpdateLoop()
 	}
-	g.client.SharedGameObjects().Players.Remove(g.client.Id())
+	g.arena.SharedGameObjects.Players.Remove(g.client.Id())
+	g.breakCombo()
 	g.syncPlayerBestScore()
 }
 
@@ -88,8 +265,17 @@ func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_
 		return
 	}
 
+	g.mux.Lock()
+	defer g.unlock()
+
 	g.player.Direction = message.PlayerDirection.Direction
 
+	// Clients that don't say how hard to steer always move at full speed
+	g.player.Intensity = 1
+	if throttle, ok := message.PlayerDirection.Throttle.(*packets.PlayerDirectionMessage_Intensity); ok && !math.IsNaN(throttle.Intensity) {
+		g.player.Intensity = min(max(throttle.Intensity, 0), 1)
+	}
+
 	// If this is the first time receiving a player direction message from our client, start the player update loop
 	if g.cancelPlayerUpdateLoop == nil {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -100,8 +286,13 @@ func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_
 
 func (g *InGame) handleChat(senderId uint64, message *packets.Packet_Chat) {
 	if senderId == g.client.Id() {
+		if message.Chat.TeamOnly && g.player.Team == 0 {
+			g.client.SocketSend(packets.NewDenyResponse("You're not on a team"))
+			return
+		}
+		message.Chat.Team = g.player.Team
 		g.client.Broadcast(message)
-	} else {
+	} else if canSeeChat(message.Chat, g.player.Team) {
 		g.client.SocketSendAs(message, senderId)
 	}
 }
@@ -112,10 +303,13 @@ func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_Sp
 		return
 	}
 
+	g.mux.Lock()
+	defer g.unlock()
+
 	// If the spore was supposedly consumed by our player, we need to verify the plausibility of the event
 	errMsg := "Could not verify spore consumption: "
 
-	// First, check if the spore exists
+	// First, check if the spore and the cell that supposedly consumed it exist
 	sporeId := message.SporeConsumed.SporeId
 	spore, err := g.getSpore(sporeId)
 	if err != nil {
@@ -123,29 +317,52 @@ func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_Sp
 		return
 	}
 
+	cell, err := g.getCell(message.SporeConsumed.CellId)
+	if err != nil {
+		g.logger.Println(errMsg + err.Error())
+		return
+	}
+
 	// Next, check if the spore is closed enough to be consumed
-	err = g.validatePlayerCloseToObject(spore.X, spore.Y, spore.Radius, 10)
+	err = g.validatePlayerCloseToObject(cell, spore.X, spore.Y, spore.Radius, 10)
 	if err != nil {
 		g.logger.Println(errMsg + err.Error())
 		return
 	}
 
 	// Finally, check if the spore wasn't dropped by the player too recently
-	err = g.validatePlayerDropCooldown(spore, 10)
+	err = g.validatePlayerDropCooldown(cell, spore, 10)
 	if err != nil {
 		g.logger.Println(errMsg + err.Error())
 		return
 	}
 
-	// If we made this far, the spore consumption is valid, so grow the player, remove the spore, and broadcast the event
-	sporeMass := radToMass(spore.Radius)
-	g.player.Radius = g.nextRadius(sporeMass)
+	// If we made this far, the spore consumption is valid, so remove the spore. Should it be eaten
+	// twice, only the first to remove it gets the mass.
+	if !g.arena.SharedGameObjects.Spores.Remove(sporeId) {
+		g.logger.Println(errMsg + "the spore was already consumed")
+		return
+	}
 
-	go g.client.SharedGameObjects().Spores.Remove(sporeId)
+	// Then grow (or for poison, shrink) the cell, and broadcast the event
+	sporeMass := radToMass(spore.Radius) * g.arena.FoodValue(spore.Kind)
+	switch {
+	case sporeMass <= 0:
+		g.breakCombo()
+	case spore.DroppedBy != nil:
+		// Spores players drop or eject don't count towards combos, or they could farm their own mass
+		sporeMass = g.massGain(sporeMass)
+	default:
+		sporeMass = g.massGain(sporeMass) * g.extendCombo()
+	}
+	cell.Radius = massToRad(max(radToMass(cell.Radius)+sporeMass, radToMass(minCellRadius)))
+	g.player.PeakMass = max(g.player.PeakMass, g.player.Mass())
+	g.player.SporesEaten++
+	g.player.SetProtectedUntil(time.Time{})
 
-	g.client.Broadcast(message)
+	g.broadcast(message)
 
-	go g.syncPlayerBestScore()
+	g.syncPlayerBestScore()
 }
 
 func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_PlayerConsumed) {
@@ -153,66 +370,319 @@ func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_P
 		g.client.SocketSendAs(message, senderId)
 
 		if message.PlayerConsumed.PlayerId == g.client.Id() {
-			g.logger.Println("Player was consumed, respawning")
-			g.client.SetState(&InGame{
-				player: &objects.Player{
-					Name: g.player.Name,
-				},
-			})
+			g.handleCellLost(senderId, message.PlayerConsumed.CellId)
 		}
 
 		return
 	}
 
+	g.mux.Lock()
+	defer g.unlock()
+
 	// If the other player was supposedly consumed by our player, we need to verify the plausibility of the event
 	errMsg := "Could not verify player consumption: "
 
-	// First check if the player exists
+	// First check if the player and both cells involved exist
 	otherId := message.PlayerConsumed.PlayerId
+	if otherId == g.client.Id() {
+		g.logger.Println(errMsg + "player can't consume their own cells")
+		return
+	}
+
 	other, err := g.getOtherPlayer(otherId)
 	if err != nil {
 		g.logger.Println(errMsg + err.Error())
 		return
 	}
 
-	// Next, check if the other player is closed enough to be consumed
-	err = g.validatePlayerCloseToObject(other.X, other.Y, other.Radius, 10)
+	// The other player's cells are moved by their own loop, so go by where it last published them
+	otherCellId := message.PlayerConsumed.CellId
+	otherCell, exists := other.PositionOf(otherCellId)
+	if !exists {
+		g.logger.Printf(errMsg+"cell with ID %d does not exist", otherCellId)
+		return
+	}
+
+	cell, err := g.getCell(message.PlayerConsumed.EaterCellId)
 	if err != nil {
 		g.logger.Println(errMsg + err.Error())
 		return
 	}
 
-	// Finally, check the other player's mass is less than our player's
-	ourMass := radToMass(g.player.Radius)
-	otherMass := radToMass(other.Radius)
+	// Next, check if the other player's cell is closed enough to be consumed
+	err = g.validatePlayerCloseToObject(cell, otherCell.X, otherCell.Y, otherCell.Radius, 10)
+	if err != nil {
+		g.logger.Println(errMsg + err.Error())
+		return
+	}
+
+	// In modes where players tag each other, the other player is tagged rather than eaten
+	if g.tag(otherId, other) {
+		return
+	}
+
+	if !g.arena.Mode.CanConsume(g.player, other) {
+		g.logger.Println(errMsg + "the game mode doesn't allow it")
+		return
+	}
+
+	// Shielded and newly spawned players can't be consumed at all
+	if _, shielded := other.Effect(objects.PowerUpShield); shielded {
+		g.logger.Println(errMsg + "the other player is shielded")
+		return
+	}
+	if other.IsProtected() {
+		g.logger.Println(errMsg + "the other player has only just spawned")
+		return
+	}
+
+	// Finally, check the other cell's mass is less than our cell's
+	ourMass := radToMass(cell.Radius)
+	otherMass := radToMass(otherCell.Radius)
 	if ourMass <= otherMass*1.5 {
-		g.logger.Printf(errMsg+"player not massive enough to consume the other player (our radius: %f, other radius: %f)", g.player.Radius, other.Radius)
+		g.logger.Printf(errMsg+"cell not massive enough to consume the other cell (our radius: %f, other radius: %f)", cell.Radius, otherCell.Radius)
+		return
+	}
+
+	// If we made it this far, the player consumption is valid, so remove the consumed cell. If that
+	// was the other player's last cell they're out of the game, otherwise they will rearrange their
+	// remaining cells themselves when they receive the event, and until then their main body is
+	// claimed. Should the same cell be eaten twice, only the first to remove or claim it gets the mass.
+	killed := false
+	if otherCellId != 0 {
+		if !other.Cells.Remove(otherCellId) {
+			g.logger.Println(errMsg + "the other cell was already consumed")
+			return
+		}
+	} else if other.Cells.Len() <= 0 {
+		if !g.arena.SharedGameObjects.Players.Remove(otherId) {
+			g.logger.Println(errMsg + "the other player was already consumed")
+			return
+		}
+		killed = true
+	} else if !other.ClaimMainBody() {
+		g.logger.Println(errMsg + "the other cell was already consumed")
+		return
+	}
+
+	// Then grow the cell and broadcast the event
+	cell.Radius = nextRadius(cell.Radius, g.massGain(otherMass))
+	g.player.PeakMass = max(g.player.PeakMass, g.player.Mass())
+	g.player.SetProtectedUntil(time.Time{})
+
+	g.broadcast(message)
+
+	if killed {
+		killFeed := packets.NewKillFeed(g.client.Id(), g.player.Name, otherId, other.Name, otherMass)
+		g.broadcast(killFeed)
+		g.client.SocketSend(killFeed)
+
+		g.player.KillStreak++
+		if streak := g.player.KillStreak; streak == killStreakAnnouncement || streak > killStreakAnnouncement && streak%5 == 0 {
+			g.announce(server.AnnouncementKillStreak, fmt.Sprintf("%s is on a %d kill streak", g.player.Name, streak))
+		}
+		if g.player.KillStreak >= server.BountyKillStreak {
+			g.arena.PlaceBounty(g.client.Id(), g.player, fmt.Sprintf("a %d kill streak", g.player.KillStreak))
+		}
+
+		if reward := g.arena.ClaimBounty(g.client.Id(), g.player, other); reward > 0 {
+			cell.Radius = nextRadius(cell.Radius, reward)
+			g.player.PeakMass = max(g.player.PeakMass, g.player.Mass())
+			go g.recordBountyClaim(reward)
+		}
+	}
+
+	g.syncPlayerBestScore()
+}
+
+// Lose one of our cells to another player. If it was our main body, the biggest remaining cell
+// takes its place, and if there are none left, the player is dead.
+func (g *InGame) handleCellLost(killerId uint64, cellId uint64) {
+	g.mux.Lock()
+	defer g.unlock()
+
+	if cellId != 0 {
+		g.player.Cells.Remove(cellId)
+		return
+	}
+
+	if promotedId, promoted := g.largestCell(); promoted != nil {
+		g.player.Cells.Remove(promotedId)
+		g.player.Cell = *promoted
+		g.player.ReplaceMainBody()
+
+		// This is being processed on behalf of another client, so don't block on the broadcast
+		cellRemoved := packets.NewCellRemoved(g.client.Id(), promotedId)
+		go g.client.Broadcast(cellRemoved)
+		g.client.SocketSend(cellRemoved)
+		return
+	}
+
+	g.logger.Printf("Player was consumed by client %d", killerId)
+	killerName := ""
+	if killer, err := g.getOtherPlayer(killerId); err == nil {
+		killerName = killer.Name
+	}
+	g.later(func() {
+		g.client.SetState(&Dead{
+			player:     g.player,
+			killerId:   killerId,
+			killerName: killerName,
+		})
+	})
+}
+
+func (g *InGame) handleSplit(senderId uint64, message *packets.Packet_Split) {
+	if senderId != g.client.Id() {
+		g.logger.Println("Received split message from a different client, ignoring")
+		return
+	}
+
+	g.mux.Lock()
+	defer g.unlock()
+
+	g.split()
+}
+
+func (g *InGame) handleEjectMass(senderId uint64, message *packets.Packet_EjectMass) {
+	if senderId != g.client.Id() {
+		g.logger.Println("Received eject mass message from a different client, ignoring")
+		return
+	}
+
+	g.mux.Lock()
+	defer g.unlock()
+
+	if time.Since(g.lastEjectAt) < ejectCooldown {
+		return
+	}
+	g.lastEjectAt = time.Now()
+
+	g.ejectMass()
+}
+
+func (g *InGame) handleBoost(senderId uint64, message *packets.Packet_Boost) {
+	if senderId != g.client.Id() {
+		g.logger.Println("Received boost message from a different client, ignoring")
 		return
 	}
 
-	// If we made it this far, the player consumption is valid, so grow the player, remove the consumed other, and broadcast the event
-	g.player.Radius = g.nextRadius(otherMass)
+	g.mux.Lock()
+	defer g.unlock()
 
-	go g.client.SharedGameObjects().Players.Remove(otherId)
+	g.boosting = message.Boost.Active
+}
 
-	g.client.Broadcast(message)
+// Everyone starts the next round afresh. This is being processed on behalf of the arena, so the
+// player's own loop is left to do that.
+func (g *InGame) handleRoundOver(senderId uint64, message *packets.Packet_RoundOver) {
+	if senderId != 0 {
+		g.logger.Println("Received round over message from a client, ignoring")
+		return
+	}
+
+	g.client.SocketSendAs(message, senderId)
 
-	go g.syncPlayerBestScore()
+	// If the player hasn't started moving yet, they start afresh as soon as they do
+	select {
+	case g.roundOver <- struct{}{}:
+	default:
+	}
+}
+
+// A teammate passed us some of their mass. They've already handed it to our player, so this only
+// lets the client know.
+func (g *InGame) handleMassShare(senderId uint64, message *packets.Packet_MassShare) {
+	if senderId == g.client.Id() {
+		g.logger.Println("Received mass share message from our own client, ignoring")
+		return
+	}
+
+	if message.MassShare.PlayerId == g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+	}
+}
+
+func (g *InGame) handlePowerUpCollected(senderId uint64, message *packets.Packet_PowerUpCollected) {
+	if senderId != g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+	}
+}
+
+func (g *InGame) handleCellRemoved(senderId uint64, message *packets.Packet_CellRemoved) {
+	if senderId != g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+	}
 }
 
 func (g *InGame) handleSpore(senderId uint64, message *packets.Packet_Spore) {
 	g.client.SocketSendAs(message, senderId)
 }
 
+// Only pass on hazards the client can see, or ones it has already been told about so it doesn't
+// lose track of them as they move away
+func (g *InGame) handleHazard(senderId uint64, message *packets.Packet_Hazard) {
+	g.mux.Lock()
+	defer g.unlock()
+
+	hazardId := message.Hazard.Id
+	hazard, exists := g.arena.SharedGameObjects.Hazards.Get(hazardId)
+	if !exists {
+		return
+	}
+
+	if g.isNearby(hazard.X, hazard.Y, hazard.Radius) {
+		g.visibleHazards.Add(hazard, hazardId)
+	} else if _, visible := g.visibleHazards.Get(hazardId); visible {
+		g.visibleHazards.Remove(hazardId)
+	} else {
+		return
+	}
+
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handleHazardConsumed(senderId uint64, message *packets.Packet_HazardConsumed) {
+	if senderId == g.client.Id() {
+		return
+	}
+
+	g.visibleHazards.Remove(message.HazardConsumed.HazardId)
+	g.client.SocketSendAs(message, senderId)
+}
+
 func (g *InGame) handleDisconnect(senderId uint64, message *packets.Packet_Disconnect) {
 	if senderId == g.client.Id() {
 		g.client.Broadcast(message)
-		g.client.SetState(&Connected{})
+		g.client.SetState(&Connected{playerId: g.player.DbId})
 	} else {
 		go g.client.SocketSendAs(message, senderId)
 	}
 }
 
+func (g *InGame) handleKickPlayer(senderId uint64, message *packets.Packet_KickPlayer) {
+	if handleKick(g.client, g.arena, g.logger, senderId, message) {
+		g.client.Broadcast(packets.NewDisconnect("kicked by the host"))
+		g.client.SetState(&Connected{playerId: g.player.DbId})
+	}
+}
+
+func (g *InGame) handleSpectateRequest(senderId uint64, message *packets.Packet_SpectateRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	// Stay in the same arena, but leave the world and watch it instead, e.g. to follow our killer
+	if err := g.client.SpectateArena(g.arena.Id, g.arena.InviteCode, g.player.DbId); err != nil {
+		g.logger.Printf("Failed to spectate arena %d: %v", g.arena.Id, err)
+		g.client.SocketSend(packets.NewDenyResponse("Could not spectate this arena"))
+		return
+	}
+
+	g.client.Broadcast(packets.NewDisconnect("spectating"))
+	g.client.SetState(&Spectating{playerId: g.player.DbId, followingId: message.SpectateRequest.PlayerId})
+}
+
 func (g *InGame) playerUpdateLoop(ctx context.Context) {
 	const delta float64 = 0.05
 	ticker := time.NewTicker(time.Duration(delta*1000) * time.Millisecond)
@@ -221,22 +691,81 @@ func (g *InGame) playerUpdateLoop(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			g.syncPlayer(delta)
+			g.update(ctx, func() { g.syncPlayer(delta) })
+		case <-g.roundOver:
+			g.update(ctx, g.startNextRound)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// Apply the update to the player, unless they left the game while it was waiting for its turn
+func (g *InGame) update(ctx context.Context, update func()) {
+	g.mux.Lock()
+	defer g.unlock()
+
+	if ctx.Err() == nil {
+		update()
+	}
+}
+
+// Let go of the player, publishing where their cells ended up for other goroutines to see, then
+// do whatever was held back in the meantime
+func (g *InGame) unlock() {
+	g.player.PublishPositions()
+	heldBack := g.heldBack
+	g.heldBack = nil
+	g.mux.Unlock()
+
+	for _, action := range heldBack {
+		action()
+	}
+}
+
+// Hold the action back until the player is let go of
+func (g *InGame) later(action func()) {
+	g.heldBack = append(g.heldBack, action)
+}
+
+// Broadcast the message once the player is let go of
+func (g *InGame) broadcast(message packets.Msg) {
+	g.later(func() { g.client.Broadcast(message) })
+}
+
+// Announce a milestone of the player's once they are let go of
+func (g *InGame) announce(kind string, text string) {
+	g.later(func() { g.arena.Announce(kind, g.client.Id(), text) })
+}
+
 func (g *InGame) syncPlayer(delta float64) {
-	newX := g.player.X + g.player.Speed*math.Cos(g.player.Direction)*delta
-	newY := g.player.Y + g.player.Speed*math.Sin(g.player.Direction)*delta
+	g.syncSpeed(delta)
 
-	g.player.X = newX
-	g.player.Y = newY
+	g.syncVelocity(delta)
+	moveCell(g.arena.World, &g.player.Cell, g.player.VelX, g.player.VelY, delta)
 
-	// Drop a spore
-	probability := g.player.Radius / float64(server.MaxSpores*5)
+	g.syncCells(delta)
+	g.takeSharedMass()
+	g.player.ZonePoints += g.player.TakeZonePoints()
+	g.decay(delta)
+	g.collideWithHazards()
+	g.arena.Mode.TickPlayer(g.client.Id(), g.player, delta)
+	g.tagTouchingPlayers()
+	g.collectPowerUps()
+	g.pullSpores(delta)
+	g.syncHazards()
+
+	g.announceMassMilestones()
+
+	if g.combo > 0 && time.Since(g.lastSporeAt) > comboWindow {
+		g.breakCombo()
+	}
+
+	// Drop a spore, unless the arena has no room for any
+	probability := 0.0
+	if g.arena.MaxSpores > 0 {
+		probability = g.player.Radius / float64(g.arena.MaxSpores*5)
+	}
 	if rand.Float64() < probability && g.player.Radius > 10 {
 		spore := &objects.Spore{
 			X:         g.player.X,
@@ -245,26 +774,543 @@ func (g *InGame) syncPlayer(delta float64) {
 			DroppedBy: g.player,
 			DroppedAt: time.Now(),
 		}
-		sporeId := g.client.SharedGameObjects().Spores.Add(spore)
-		g.client.Broadcast(packets.NewSpore(sporeId, spore))
+		sporeId := g.arena.SharedGameObjects.Spores.Add(spore)
+		g.broadcast(packets.NewSpore(sporeId, spore))
 		go g.client.SocketSend(packets.NewSpore(sporeId, spore))
-		g.player.Radius = g.nextRadius(-radToMass(spore.Radius))
+		g.player.Radius = nextRadius(g.player.Radius, -radToMass(spore.Radius))
 	}
 
 	// Broadcast the updated player state
 	updatePlayer := packets.NewPlayer(g.client.Id(), g.player)
-	g.client.Broadcast(updatePlayer)
+	g.broadcast(updatePlayer)
 	go g.client.SocketSend(updatePlayer)
 }
 
-func (g *InGame) sendInitialSpores(batchSize int, delay time.Duration) {
+// Record the player's score for the round that just ended, and start them afresh
+func (g *InGame) startNextRound() {
+	g.breakCombo()
+	g.syncPlayerBestScore()
+
+	g.player.Cells.ForEach(func(cellId uint64, _ *objects.Cell) {
+		g.player.Cells.Remove(cellId)
+
+		cellRemoved := packets.NewCellRemoved(g.client.Id(), cellId)
+		g.broadcast(cellRemoved)
+		go g.client.SocketSend(cellRemoved)
+	})
+
+	g.spawn()
+	go g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player))
+}
+
+// Slow the player down as they gain mass, and speed them up while they are boosting, at the cost
+// of some mass from every cell
+func (g *InGame) syncSpeed(delta float64) {
+	if g.boosting && g.player.Radius < minBoostRadius {
+		g.boosting = false
+	}
+
+	if g.boosting {
+		for _, cell := range g.allCells() {
+			cell.Radius = massToRad(radToMass(cell.Radius) * (1 - boostMassCostRate*delta))
+		}
+	}
+
+	g.player.Speed = g.speedOf(&g.player.Cell)
+}
+
+// The speed the cell moves at for its mass, sped up by power-ups and boosting
+func (g *InGame) speedOf(cell *objects.Cell) float64 {
+	speed := g.arena.SpeedCurve.Speed(cell.Mass())
+	if effect, active := g.player.Effect(objects.PowerUpSpeed); active {
+		speed *= effect.Strength
+	}
+	if g.boosting {
+		speed *= boostSpeedMultiplier
+	}
+	return speed
+}
+
+// Ramp the velocity of each of the player's cells towards where they're steering. Letting go of
+// the controls leaves friction to bring them to a stop, while steering takes longer to turn heavier
+// cells around.
+func (g *InGame) syncVelocity(delta float64) {
+	dirX := g.player.Intensity * math.Cos(g.player.Direction)
+	dirY := g.player.Intensity * math.Sin(g.player.Direction)
+
+	for _, cell := range g.allCells() {
+		targetSpeed := g.speedOf(cell)
+		targetX, targetY := targetSpeed*dirX, targetSpeed*dirY
+
+		rate := frictionRate
+		if g.player.Intensity > 0 {
+			rate = steeringRate / (1 + cell.Mass()/steeringHalfMass)
+		}
+
+		blend := 1 - math.Exp(-rate*delta)
+		cell.VelX += (targetX - cell.VelX) * blend
+		cell.VelY += (targetY - cell.VelY) * blend
+	}
+}
+
+// Move the split-off cells along with the main body, and merge them back in once their cooldown is over
+func (g *InGame) syncCells(delta float64) {
+	g.player.Cells.ForEach(func(cellId uint64, cell *objects.Cell) {
+		velX, velY := cell.VelX, cell.VelY
+
+		// Pull cells that are ready to merge toward the main body
+		canMerge := time.Now().After(cell.MergeAt)
+		toMainX, toMainY := g.arena.World.Delta(cell.X, cell.Y, g.player.X, g.player.Y)
+		distToMain := math.Hypot(toMainX, toMainY)
+		if canMerge && distToMain > 0 {
+			velX += g.player.Speed * toMainX / distToMain
+			velY += g.player.Speed * toMainY / distToMain
+		}
+
+		moveCell(g.arena.World, cell, velX, velY, delta)
+
+		if canMerge && g.arena.World.Dist(g.player.X, g.player.Y, cell.X, cell.Y) <= max(g.player.Radius, cell.Radius) {
+			g.player.Radius = nextRadius(g.player.Radius, radToMass(cell.Radius))
+			g.player.Cells.Remove(cellId)
+
+			cellRemoved := packets.NewCellRemoved(g.client.Id(), cellId)
+			g.broadcast(cellRemoved)
+			go g.client.SocketSend(cellRemoved)
+			return
+		}
+
+		updateCell := packets.NewPlayerCell(g.client.Id(), cellId, g.player, cell)
+		g.broadcast(updateCell)
+		go g.client.SocketSend(updateCell)
+	})
+}
+
+// Split every cell that is big enough in two, launching the new halves in the direction of movement
+func (g *InGame) split() {
+	cells := g.allCells()
+	dirX := math.Cos(g.player.Direction)
+	dirY := math.Sin(g.player.Direction)
+	mergeAt := time.Now().Add(mergeCooldown)
+
+	for _, cell := range cells {
+		if g.player.Cells.Len()+1 >= maxCells {
+			break
+		}
+		if cell.Radius < minSplitRadius {
+			continue
+		}
+
+		cell.Radius = massToRad(radToMass(cell.Radius) / 2)
+		cell.MergeAt = mergeAt
+
+		split := &objects.Cell{
+			Radius:  cell.Radius,
+			VelX:    cell.VelX,
+			VelY:    cell.VelY,
+			LaunchX: dirX * splitLaunchSpeed,
+			LaunchY: dirY * splitLaunchSpeed,
+			MergeAt: mergeAt,
+		}
+		split.X, split.Y = g.arena.World.Confine(cell.X+dirX*cell.Radius, cell.Y+dirY*cell.Radius, split.Radius)
+		g.player.Cells.Add(split)
+	}
+}
+
+// Spend some of the mass of every cell that is big enough to launch a spore from it in the direction of movement
+func (g *InGame) ejectMass() {
+	cells := g.allCells()
+	dirX := math.Cos(g.player.Direction)
+	dirY := math.Sin(g.player.Direction)
+
+	for _, cell := range cells {
+		if cell.Radius < minEjectRadius {
+			continue
+		}
+
+		// Teammates close enough get the mass straight away, rather than having to catch it
+		if teammateId, teammate, exists := g.nearestTeammate(cell); exists {
+			mass := radToMass(ejectSporeRadius)
+			cell.Radius = nextRadius(cell.Radius, -mass)
+			teammate.ShareMass(mass)
+			g.later(func() { g.client.PassToPeer(packets.NewMassShare(teammateId, mass), teammateId) })
+			continue
+		}
+
+		spore := &objects.Spore{
+			Radius:    ejectSporeRadius,
+			DroppedBy: g.player,
+			DroppedAt: time.Now(),
+			LaunchX:   dirX * ejectLaunchSpeed,
+			LaunchY:   dirY * ejectLaunchSpeed,
+		}
+		spore.X, spore.Y = g.arena.World.Confine(cell.X+dirX*(cell.Radius+ejectSporeRadius), cell.Y+dirY*(cell.Radius+ejectSporeRadius), spore.Radius)
+		cell.Radius = nextRadius(cell.Radius, -radToMass(spore.Radius))
+
+		sporeId := g.arena.SharedGameObjects.Spores.Add(spore)
+		g.broadcast(packets.NewSpore(sporeId, spore))
+		g.client.SocketSend(packets.NewSpore(sporeId, spore))
+	}
+}
+
+// Count another spore towards the player's combo, returning the multiplier it earns them
+func (g *InGame) extendCombo() float64 {
+	if time.Since(g.lastSporeAt) > comboWindow {
+		g.breakCombo()
+	}
+	g.combo++
+	g.lastSporeAt = time.Now()
+
+	multiplier := comboMultiplier(g.combo)
+	g.client.SocketSend(packets.NewCombo(g.combo, multiplier))
+	return multiplier
+}
+
+// End the player's combo, keeping it as a stat if it's their longest this life, and let the client
+// know it's gone
+func (g *InGame) breakCombo() {
+	if g.combo == 0 {
+		return
+	}
+
+	// Like scores, combos in private matches stay out of the stats unless the host opted in
+	if g.combo > g.savedCombo && g.arena.RecordHiscores {
+		g.savedCombo = g.combo
+		params := db.UpdatePlayerBestComboParams{
+			PlayerID:  g.player.DbId,
+			BestCombo: int64(g.combo),
+		}
+		g.later(func() {
+			if err := g.client.DbTx().Queries.UpdatePlayerBestCombo(g.client.DbTx().Ctx, params); err != nil {
+				g.logger.Printf("Error updating player best combo: %v", err)
+			}
+		})
+	}
+
+	g.combo = 0
+	g.client.SocketSend(packets.NewCombo(0, 1))
+}
+
+// The first spore of a combo is worth its usual mass, and each one after it a bit more
+func comboMultiplier(combo int) float64 {
+	return min(1+comboMultiplierStep*float64(max(combo-1, 0)), maxComboMultiplier)
+}
+
+// Let everyone know when the player grows past one of the mass milestones
+func (g *InGame) announceMassMilestones() {
+	mass := g.player.Mass()
+	for g.massMilestone < len(massMilestones) && mass >= massMilestones[g.massMilestone] {
+		milestone := massMilestones[g.massMilestone]
+		g.announce(server.AnnouncementMassMilestone, fmt.Sprintf("%s has reached %d mass", g.player.Name, int(milestone)))
+		g.massMilestone++
+	}
+}
+
+// Tag the other player if the game mode lets us, returning whether we did
+func (g *InGame) tag(otherId uint64, other *objects.Player) bool {
+	tagMode, ok := g.arena.Mode.(server.TagMode)
+	if !ok || !tagMode.CanTag(g.player, other) {
+		return false
+	}
+
+	tagMode.Tag(otherId, other)
+	g.logger.Printf("Tagged client %d", otherId)
+	return true
+}
+
+// Touching is enough to tag someone, so there's no need to wait for the client to claim it
+func (g *InGame) tagTouchingPlayers() {
+	if _, ok := g.arena.Mode.(server.TagMode); !ok {
+		return
+	}
+
+	cells := g.allCells()
+	g.arena.SharedGameObjects.Players.ForEach(func(otherId uint64, other *objects.Player) {
+		if otherId == g.client.Id() {
+			return
+		}
+
+		// The other player's cells are moved by their own loop, so go by where it last published them
+		otherCells := other.Positions()
+		for _, cell := range cells {
+			for _, otherCell := range otherCells {
+				if g.validatePlayerCloseToObject(cell, otherCell.X, otherCell.Y, otherCell.Radius, 0) == nil {
+					g.tag(otherId, other)
+					return
+				}
+			}
+		}
+	})
+}
+
+// Returns the teammate whose main body is closest to the cell, and their ID, if any are close
+// enough to share mass with
+func (g *InGame) nearestTeammate(cell *objects.Cell) (uint64, *objects.Player, bool) {
+	if g.player.Team == 0 {
+		return 0, nil, false
+	}
+
+	var nearestId uint64
+	var nearest *objects.Player
+	nearestDistSq := teamShareRadius * teamShareRadius
+
+	g.arena.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if playerId == g.client.Id() || player.Team != g.player.Team {
+			return
+		}
+		x, y, _ := player.Position()
+		if distSq := g.arena.World.DistSq(cell.X, cell.Y, x, y); distSq < nearestDistSq {
+			nearestId, nearest, nearestDistSq = playerId, player, distSq
+		}
+	})
+	return nearestId, nearest, nearest != nil
+}
+
+// Take in any mass teammates have passed on since the last update
+func (g *InGame) takeSharedMass() {
+	if mass := g.player.TakeSharedMass(); mass > 0 {
+		g.player.Radius = nextRadius(g.player.Radius, mass)
+		g.player.PeakMass = max(g.player.PeakMass, g.player.Mass())
+	}
+}
+
+// Shrink cells above the arena's decay threshold, returning the lost mass to the world as spores
+// dropped around the player if the arena is configured to
+func (g *InGame) decay(delta float64) {
+	for _, cell := range g.allCells() {
+		loss := g.arena.MassDecay.Loss(radToMass(cell.Radius), delta)
+		if loss <= 0 {
+			continue
+		}
+		cell.Radius = nextRadius(cell.Radius, -loss)
+		g.decayedMass += loss
+	}
+
+	if !g.arena.MassDecay.ReturnAsSpores {
+		g.decayedMass = 0
+		return
+	}
+
+	sporeMass := radToMass(decaySporeRadius)
+	for g.decayedMass >= sporeMass {
+		g.decayedMass -= sporeMass
+
+		angle := rand.Float64() * 2 * math.Pi
+		dist := g.player.Radius + decaySporeRadius + rand.Float64()*g.player.Radius
+		spore := &objects.Spore{
+			Radius:    decaySporeRadius,
+			DroppedBy: g.player,
+			DroppedAt: time.Now(),
+		}
+		spore.X, spore.Y = g.arena.World.Confine(g.player.X+dist*math.Cos(angle), g.player.Y+dist*math.Sin(angle), spore.Radius)
+		sporeId := g.arena.SharedGameObjects.Spores.Add(spore)
+		g.broadcast(packets.NewSpore(sporeId, spore))
+		go g.client.SocketSend(packets.NewSpore(sporeId, spore))
+	}
+}
+
+// Send any hazards the player has come close to that the client doesn't know about yet
+func (g *InGame) syncHazards() {
+	g.arena.SharedGameObjects.Hazards.ForEach(func(hazardId uint64, hazard *objects.Hazard) {
+		if _, visible := g.visibleHazards.Get(hazardId); visible || !g.isNearby(hazard.X, hazard.Y, hazard.Radius) {
+			return
+		}
+
+		g.visibleHazards.Add(hazard, hazardId)
+		g.client.SocketSendAs(packets.NewHazard(hazardId, hazard), 0)
+	})
+}
+
+// Run any cells that are massive enough into the hazards whose centres they cover
+func (g *InGame) collideWithHazards() {
+	for cellId, cell := range g.allCells() {
+		g.arena.SharedGameObjects.Hazards.ForEach(func(hazardId uint64, hazard *objects.Hazard) {
+			if radToMass(cell.Radius) < radToMass(hazard.Radius)*hazardBurstMassRatio {
+				return
+			}
+			if g.arena.World.Dist(cell.X, cell.Y, hazard.X, hazard.Y) > cell.Radius {
+				return
+			}
+
+			// Another cell, or another player, may have run into the same hazard already
+			if !g.arena.SharedGameObjects.Hazards.Remove(hazardId) {
+				return
+			}
+			g.visibleHazards.Remove(hazardId)
+
+			hazardConsumed := packets.NewHazardConsumed(hazardId, cellId)
+			g.broadcast(hazardConsumed)
+			go g.client.SocketSend(hazardConsumed)
+
+			g.burstCell(cell, radToMass(hazard.Radius))
+		})
+	}
+}
+
+// Burst the cell into pieces flying off in every direction, after it takes on the hazard's mass.
+// If the player has no room for more cells, the hazard drains some of the cell's mass instead.
+func (g *InGame) burstCell(cell *objects.Cell, hazardMass float64) {
+	pieces := min(maxBurstPieces, maxCells-g.player.Cells.Len())
+	if pieces <= 1 {
+		cell.Radius = massToRad(radToMass(cell.Radius) * (1 - hazardDrainFraction))
+		return
+	}
+
+	mass := radToMass(cell.Radius) + hazardMass
+	g.player.PeakMass = max(g.player.PeakMass, g.player.Mass()+hazardMass)
+
+	cell.Radius = massToRad(mass / float64(pieces))
+	cell.MergeAt = time.Now().Add(mergeCooldown)
+
+	for i := 1; i < pieces; i++ {
+		angle := 2 * math.Pi * float64(i) / float64(pieces-1)
+		dirX, dirY := math.Cos(angle), math.Sin(angle)
+		piece := &objects.Cell{
+			Radius:  cell.Radius,
+			VelX:    cell.VelX,
+			VelY:    cell.VelY,
+			LaunchX: dirX * splitLaunchSpeed,
+			LaunchY: dirY * splitLaunchSpeed,
+			MergeAt: cell.MergeAt,
+		}
+		piece.X, piece.Y = g.arena.World.Confine(cell.X+dirX*cell.Radius, cell.Y+dirY*cell.Radius, piece.Radius)
+		g.player.Cells.Add(piece)
+	}
+}
+
+// Collect any power-ups the player's cells run over, and apply their effects
+func (g *InGame) collectPowerUps() {
+	for _, cell := range g.allCells() {
+		g.arena.SharedGameObjects.PowerUps.ForEach(func(powerUpId uint64, powerUp *objects.PowerUp) {
+			if g.arena.World.Dist(cell.X, cell.Y, powerUp.X, powerUp.Y) > cell.Radius+powerUp.Radius {
+				return
+			}
+
+			// Another cell, or another player, may have collected the same power-up already
+			if !g.arena.SharedGameObjects.PowerUps.Remove(powerUpId) {
+				return
+			}
+
+			effect, exists := g.arena.PowerUpEffect(powerUp.Kind)
+			if !exists {
+				g.logger.Printf("Collected power-up of unknown kind %s", powerUp.Kind)
+				return
+			}
+			duration := time.Duration(effect.Duration * float64(time.Second))
+			g.addEffect(objects.ActiveEffect{Kind: effect.Kind, Strength: effect.Strength, ExpiresAt: time.Now().Add(duration)})
+
+			collected := packets.NewPowerUpCollected(powerUpId, effect.Kind, duration)
+			g.broadcast(collected)
+			go g.client.SocketSend(collected)
+		})
+	}
+}
+
+// Give the player the effect, replacing any of the same kind and dropping the ones that wore off
+func (g *InGame) addEffect(added objects.ActiveEffect) {
+	effects := []objects.ActiveEffect{added}
+	for _, effect := range g.player.Effects() {
+		if effect.Kind != added.Kind && time.Now().Before(effect.ExpiresAt) {
+			effects = append(effects, effect)
+		}
+	}
+	g.player.SetEffects(effects)
+}
+
+// Pull the spores within reach of a magnet toward the player's main body
+func (g *InGame) pullSpores(delta float64) {
+	magnet, active := g.player.Effect(objects.PowerUpMagnet)
+	if !active {
+		return
+	}
+
+	g.arena.SharedGameObjects.Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		dx, dy := g.arena.World.Delta(spore.X, spore.Y, g.player.X, g.player.Y)
+		dist := math.Hypot(dx, dy)
+		if dist > magnet.Strength+g.player.Radius || dist <= 0 {
+			return
+		}
+
+		// Others may be looking at the spore, so pull a copy of it instead
+		step := min(magnetPullSpeed*delta, dist)
+		pulled := *spore
+		pulled.X, pulled.Y = g.arena.World.Confine(spore.X+dx/dist*step, spore.Y+dy/dist*step, spore.Radius)
+		if !g.arena.SharedGameObjects.Spores.Replace(sporeId, &pulled) {
+			return
+		}
+
+		updateSpore := packets.NewSpore(sporeId, &pulled)
+		g.broadcast(updateSpore)
+		go g.client.SocketSend(updateSpore)
+	})
+}
+
+// Returns how much mass the player gains from consuming the given mass, after any power-ups
+func (g *InGame) massGain(mass float64) float64 {
+	if effect, active := g.player.Effect(objects.PowerUpDoubleMass); active {
+		return mass * effect.Strength
+	}
+	return mass
+}
+
+// Whether an object is within range of any of the player's cells to be worth telling the client about
+func (g *InGame) isNearby(x, y, radius float64) bool {
+	for _, cell := range g.allCells() {
+		if g.arena.World.Dist(cell.X, cell.Y, x, y) <= hazardInterestRadius+cell.Radius+radius {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns all the player's cells by ID, including the main body as cell 0
+func (g *InGame) allCells() map[uint64]*objects.Cell {
+	cells := map[uint64]*objects.Cell{0: &g.player.Cell}
+	g.player.Cells.ForEach(func(cellId uint64, cell *objects.Cell) {
+		cells[cellId] = cell
+	})
+	return cells
+}
+
+// Returns the player's biggest split-off cell and its ID, or nil if the player is in one piece
+func (g *InGame) largestCell() (uint64, *objects.Cell) {
+	var largestId uint64
+	var largest *objects.Cell
+	g.player.Cells.ForEach(func(cellId uint64, cell *objects.Cell) {
+		if largest == nil || cell.Radius > largest.Radius {
+			largestId, largest = cellId, cell
+		}
+	})
+	return largestId, largest
+}
+
+// Move the cell with the given velocity, plus whatever is left of its launch velocity, without
+// letting it leave the world
+func moveCell(world *objects.World, cell *objects.Cell, velX, velY, delta float64) {
+	cell.X += (velX + cell.LaunchX) * delta
+	cell.Y += (velY + cell.LaunchY) * delta
+	cell.X, cell.Y = world.Confine(cell.X, cell.Y, cell.Radius)
+
+	decay := math.Exp(-objects.LaunchDecayRate * delta)
+	cell.LaunchX *= decay
+	cell.LaunchY *= decay
+}
+
+// Send all the power-ups in the collection to the client
+func sendPowerUps(client server.ClientInterfacer, powerUps *objects.SharedCollection[*objects.PowerUp]) {
+	powerUps.ForEach(func(powerUpId uint64, powerUp *objects.PowerUp) {
+		client.SocketSendAs(packets.NewPowerUp(powerUpId, powerUp), 0)
+	})
+}
+
+// Send all the spores in the collection to the client in batches, pausing between each batch
+func sendInitialSpores(client server.ClientInterfacer, spores *objects.SharedCollection[*objects.Spore], batchSize int, delay time.Duration) {
 	sporesBatch := make(map[uint64]*objects.Spore, batchSize)
 
-	g.client.SharedGameObjects().Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+	spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
 		sporesBatch[sporeId] = spore
 
 		if len(sporesBatch) >= batchSize {
-			g.client.SocketSend(packets.NewSporesBatch(sporesBatch))
+			client.SocketSend(packets.NewSporesBatch(sporesBatch))
 			sporesBatch = make(map[uint64]*objects.Spore, batchSize)
 			time.Sleep(delay)
 		}
@@ -272,12 +1318,12 @@ func (g *InGame) sendInitialSpores(batchSize int, delay time.Duration) {
 
 	// Send any remaining spores
 	if len(sporesBatch) > 0 {
-		g.client.SocketSend(packets.NewSporesBatch(sporesBatch))
+		client.SocketSend(packets.NewSporesBatch(sporesBatch))
 	}
 }
 
 func (g *InGame) getSpore(sporeId uint64) (*objects.Spore, error) {
-	spore, exists := g.client.SharedGameObjects().Spores.Get(sporeId)
+	spore, exists := g.arena.SharedGameObjects.Spores.Get(sporeId)
 	if !exists {
 		return nil, fmt.Errorf("spore with ID %d does not exist", sporeId)
 	}
@@ -285,19 +1331,34 @@ func (g *InGame) getSpore(sporeId uint64) (*objects.Spore, error) {
 }
 
 func (g *InGame) getOtherPlayer(playerId uint64) (*objects.Player, error) {
-	player, exists := g.client.SharedGameObjects().Players.Get(playerId)
+	player, exists := g.arena.SharedGameObjects.Players.Get(playerId)
 	if !exists {
 		return nil, fmt.Errorf("player with ID %d does not exist", playerId)
 	}
 	return player, nil
 }
 
-func (g *InGame) validatePlayerCloseToObject(objX, objY, objRadius, buffer float64) error {
-	realDX := g.player.X - objX
-	realDY := g.player.Y - objY
-	realDistSq := realDX*realDX + realDY*realDY
+// Returns the player's cell with the given ID, where 0 is the main body
+func getPlayerCell(player *objects.Player, cellId uint64) (*objects.Cell, error) {
+	if cellId == 0 {
+		return &player.Cell, nil
+	}
 
-	thresholdDist := g.player.Radius + buffer + objRadius
+	cell, exists := player.Cells.Get(cellId)
+	if !exists {
+		return nil, fmt.Errorf("cell with ID %d does not exist", cellId)
+	}
+	return cell, nil
+}
+
+func (g *InGame) getCell(cellId uint64) (*objects.Cell, error) {
+	return getPlayerCell(g.player, cellId)
+}
+
+func (g *InGame) validatePlayerCloseToObject(cell *objects.Cell, objX, objY, objRadius, buffer float64) error {
+	realDistSq := g.arena.World.DistSq(cell.X, cell.Y, objX, objY)
+
+	thresholdDist := cell.Radius + buffer + objRadius
 	thresholdDistSq := thresholdDist * thresholdDist
 
 	if realDistSq > thresholdDistSq {
@@ -306,8 +1367,8 @@ func (g *InGame) validatePlayerCloseToObject(objX, objY, objRadius, buffer float
 	return nil
 }
 
-func (g *InGame) validatePlayerDropCooldown(spore *objects.Spore, buffer float64) error {
-	minAcceptableDistance := spore.Radius + g.player.Radius + buffer
+func (g *InGame) validatePlayerDropCooldown(cell *objects.Cell, spore *objects.Spore, buffer float64) error {
+	minAcceptableDistance := spore.Radius + cell.Radius + buffer
 	minAcceptableTime := time.Duration(minAcceptableDistance/g.player.Speed*1000) * time.Millisecond
 	if spore.DroppedBy == g.player && time.Since(spore.DroppedAt) < minAcceptableTime {
 		return fmt.Errorf("player dropped the spore too recently (time: %v, min acceptable time: %v)", time.Since(spore.DroppedAt), minAcceptableTime)
@@ -315,6 +1376,47 @@ func (g *InGame) validatePlayerDropCooldown(spore *objects.Spore, buffer float64
 	return nil
 }
 
+// Handle a kick request in any state of a client in the arena. When we're the host, the target's
+// account is kept out of the arena right away, whatever the target is doing, and the target is
+// told to leave. Returns whether we're the target, and have to leave.
+func handleKick(client server.ClientInterfacer, arena *server.Arena, logger *log.Logger, senderId uint64, message *packets.Packet_KickPlayer) bool {
+	if !arena.Private || arena.HostId() != senderId {
+		logger.Printf("Received kick request from client %d who isn't the arena host, ignoring", senderId)
+		return false
+	}
+
+	targetId := message.KickPlayer.PlayerId
+
+	// We're the host and want to kick another player
+	if senderId == client.Id() {
+		if targetId == client.Id() {
+			logger.Println("Host tried to kick themselves, ignoring")
+			return false
+		}
+		playerId, exists := arena.AccountOf(targetId)
+		if !exists {
+			client.SocketSend(packets.NewDenyResponse("No such player in this arena"))
+			return false
+		}
+		arena.Kick(playerId)
+		client.PassToPeer(message, targetId)
+		return false
+	}
+
+	if targetId != client.Id() {
+		return false
+	}
+
+	logger.Printf("Kicked from arena %d by the host", arena.Id)
+	client.SocketSend(message)
+	return true
+}
+
+// Team-only messages are only shown to the sender's teammates
+func canSeeChat(chat *packets.ChatMessage, team uint32) bool {
+	return !chat.TeamOnly || team != 0 && chat.Team == team
+}
+
 func radToMass(radius float64) float64 {
 	return math.Pi * radius * radius
 }
@@ -323,22 +1425,59 @@ func massToRad(mass float64) float64 {
 	return math.Sqrt(mass / math.Pi)
 }
 
-func (g *InGame) nextRadius(massDiff float64) float64 {
-	oldMass := radToMass(g.player.Radius)
+func nextRadius(radius float64, massDiff float64) float64 {
+	oldMass := radToMass(radius)
 	newMass := oldMass + massDiff
 	return massToRad(newMass)
 }
 
+func (g *InGame) recordBountyClaim(reward float64) {
+	if !g.arena.RecordHiscores {
+		return
+	}
+
+	err := g.client.DbTx().Queries.RecordBountyClaim(g.client.DbTx().Ctx, db.RecordBountyClaimParams{
+		PlayerID:          g.player.DbId,
+		BountyMassClaimed: int64(reward),
+	})
+	if err != nil {
+		g.logger.Printf("Error recording bounty claim: %v", err)
+	}
+}
+
 func (g *InGame) syncPlayerBestScore() {
-	currentScore := int64(math.Round(radToMass(g.player.Radius)))
+	// Private matches stay out of the global hiscores unless the host opted in
+	if !g.arena.RecordHiscores {
+		return
+	}
+
+	// Players decay after reaching their peak, so score the peak rather than the current mass
+	currentScore := int64(math.Round(max(g.player.PeakMass, g.player.Mass())))
 	if currentScore > g.player.BestScore {
 		g.player.BestScore = currentScore
-		err := g.client.DbTx().Queries.UpdatePlayerBestScore(g.client.DbTx().Ctx, db.UpdatePlayerBestScoreParams{
+		params := db.UpdatePlayerBestScoreParams{
 			ID:        g.player.DbId,
-			BestScore: g.player.BestScore,
+			BestScore: currentScore,
+		}
+		g.later(func() {
+			if err := g.client.DbTx().Queries.UpdatePlayerBestScore(g.client.DbTx().Ctx, params); err != nil {
+				g.logger.Printf("Error updating player best score: %v", err)
+			}
 		})
-		if err != nil {
-			g.logger.Printf("Error updating player best score: %v", err)
+	}
+
+	// Zone points go on their own leaderboard
+	zonePoints := int64(g.player.ZonePoints)
+	if zonePoints > g.savedZonePoints {
+		g.savedZonePoints = zonePoints
+		params := db.UpdatePlayerBestZoneScoreParams{
+			PlayerID:  g.player.DbId,
+			BestScore: zonePoints,
 		}
+		g.later(func() {
+			if err := g.client.DbTx().Queries.UpdatePlayerBestZoneScore(g.client.DbTx().Ctx, params); err != nil {
+				g.logger.Printf("Error updating player best zone score: %v", err)
+			}
+		})
 	}
 }
@@ -0,0 +1,119 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+type Spectating struct {
+	client server.ClientInterfacer
+	arena  *server.Arena
+	logger *log.Logger
+
+	// The ID of the player the spectator's camera follows, or 0 when free-roaming
+	followingId uint64
+	// The ID of the player logged in on this connection, or 0 if watching without an account
+	playerId int64
+}
+
+func (s *Spectating) Name() string {
+	return "Spectating"
+}
+
+func (s *Spectating) SetClient(client server.ClientInterfacer) {
+	s.client = client
+	s.arena = client.Arena()
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), s.Name())
+	s.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+}
+
+func (s *Spectating) OnEnter() {
+	s.client.SocketSend(packets.NewWorld(s.arena.World, s.arena.MapName))
+	s.follow(s.followingId)
+	for _, msg := range s.arena.Mode.Status() {
+		s.client.SocketSendAs(msg, 0)
+	}
+	for _, msg := range s.arena.ZoneStatus() {
+		s.client.SocketSendAs(msg, 0)
+	}
+
+	// The players are broadcast continuously, but everything else needs to be sent upfront.
+	// Spectators can roam the whole world, so they get every hazard.
+	s.arena.SharedGameObjects.Hazards.ForEach(func(hazardId uint64, hazard *objects.Hazard) {
+		s.client.SocketSendAs(packets.NewHazard(hazardId, hazard), 0)
+	})
+	go sendPowerUps(s.client, s.arena.SharedGameObjects.PowerUps)
+	go sendInitialSpores(s.client, s.arena.SharedGameObjects.Spores, 20, 50*time.Millisecond)
+}
+
+func (s *Spectating) HandleMessage(senderId uint64, message packets.Msg) {
+	// Spectators can't act on the world, so only the spectating controls are accepted from our own client
+	if senderId == s.client.Id() {
+		switch message := message.(type) {
+		case *packets.Packet_SpectateRequest:
+			s.follow(message.SpectateRequest.PlayerId)
+		case *packets.Packet_KickPlayer:
+			handleKick(s.client, s.arena, s.logger, senderId, message)
+		case *packets.Packet_FinishedSpectating:
+			s.client.SetState(&Connected{playerId: s.playerId})
+		}
+		return
+	}
+
+	switch message := message.(type) {
+	case *packets.Packet_Player, *packets.Packet_Spore, *packets.Packet_SporeConsumed, *packets.Packet_CellRemoved,
+		*packets.Packet_Hazard, *packets.Packet_HazardConsumed, *packets.Packet_PowerUp, *packets.Packet_PowerUpCollected,
+		*packets.Packet_GameMode, *packets.Packet_SafeZone, *packets.Packet_RoundOver, *packets.Packet_TeamScores,
+		*packets.Packet_Zone, *packets.Packet_KillFeed, *packets.Packet_Announcement, *packets.Packet_SporesExpired:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Chat:
+		// Spectators aren't on a team, so only see messages meant for everyone
+		if canSeeChat(message.Chat, 0) {
+			s.client.SocketSendAs(message, senderId)
+		}
+	case *packets.Packet_PlayerConsumed:
+		s.handlePlayerConsumed(senderId, message)
+	case *packets.Packet_Disconnect:
+		s.handleDisconnect(senderId, message)
+	case *packets.Packet_KickPlayer:
+		if handleKick(s.client, s.arena, s.logger, senderId, message) {
+			s.client.SetState(&Connected{playerId: s.playerId})
+		}
+	}
+}
+
+func (s *Spectating) OnExit() {
+}
+
+func (s *Spectating) handlePlayerConsumed(senderId uint64, message *packets.Packet_PlayerConsumed) {
+	s.client.SocketSendAs(message, senderId)
+
+	// Keep the action going by following whoever consumed the player we were watching
+	if message.PlayerConsumed.PlayerId == s.followingId {
+		s.follow(senderId)
+	}
+}
+
+func (s *Spectating) handleDisconnect(senderId uint64, message *packets.Packet_Disconnect) {
+	s.client.SocketSendAs(message, senderId)
+
+	if senderId == s.followingId {
+		s.follow(0)
+	}
+}
+
+// Point the spectator's camera at the given player, or let it roam freely if the ID is 0 or the
+// player isn't in the arena
+func (s *Spectating) follow(playerId uint64) {
+	if _, exists := s.arena.SharedGameObjects.Players.Get(playerId); !exists && playerId != 0 {
+		s.logger.Printf("Player %d is not in arena %d, free-roaming instead", playerId, s.arena.Id)
+		playerId = 0
+	}
+
+	s.followingId = playerId
+	s.client.SocketSend(packets.NewSpectating(s.arena.Id, playerId))
+}
@@ -0,0 +1,1649 @@
+package states_test
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/internal/server/servertest"
+	"server/internal/server/states"
+	"server/pkg/packets"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRegisterAndLogin(t *testing.T) {
+	hub := servertest.NewHub(t)
+	c, player := servertest.Join(t, hub, "alice")
+
+	if player.Name != "alice" {
+		t.Errorf("Expected player name alice, got %s", player.Name)
+	}
+	if player.Id != c.Id() {
+		t.Errorf("Expected player ID %d, got %d", c.Id(), player.Id)
+	}
+	if _, ok := c.State().(*states.InGame); !ok {
+		t.Errorf("Expected client to be in game, got state %s", c.State().Name())
+	}
+}
+
+func TestRegisterDuplicateUsername(t *testing.T) {
+	hub := servertest.NewHub(t)
+	c := servertest.Connect(t, hub)
+	servertest.Register(t, c, "bob")
+
+	c.Send(&packets.Packet_RegisterRequest{
+		RegisterRequest: &packets.RegisterRequestMessage{Username: "Bob", Password: "hunter2"},
+	})
+
+	if reason := servertest.ExpectDeny(t, c); reason != "User already exists" {
+		t.Errorf("Unexpected deny reason: %s", reason)
+	}
+}
+
+func TestLoginWrongPassword(t *testing.T) {
+	hub := servertest.NewHub(t)
+	c := servertest.Connect(t, hub)
+	servertest.Register(t, c, "carol")
+
+	c.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "carol", Password: "wrong"},
+	})
+
+	servertest.ExpectDeny(t, c)
+	if _, ok := c.State().(*states.Connected); !ok {
+		t.Errorf("Expected client to stay connected, got state %s", c.State().Name())
+	}
+}
+
+func TestChatIsRelayedToOtherPlayers(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+	bob, _ := servertest.Join(t, hub, "bob")
+
+	alice.Send(packets.NewChat("hello"))
+
+	chat, senderId := servertest.ExpectFrom[*packets.Packet_Chat](t, bob, servertest.DefaultTimeout)
+	if senderId != alice.Id() || chat.Chat.Msg != "hello" {
+		t.Errorf("Expected hello from %d, got %q from %d", alice.Id(), chat.Chat.Msg, senderId)
+	}
+	servertest.ExpectNone[*packets.Packet_Chat](t, alice, 100*time.Millisecond)
+}
+
+func TestBrowsingHiscores(t *testing.T) {
+	hub := servertest.NewHub(t)
+	c := servertest.Connect(t, hub)
+	servertest.Register(t, c, "dave")
+
+	c.Send(&packets.Packet_HiscoreBoardRequest{HiscoreBoardRequest: &packets.HiscoreBoardRequestMessage{}})
+
+	board := servertest.Expect[*packets.Packet_HiscoreBoard](t, c).HiscoreBoard
+	if len(board.Hiscores) != 1 || board.Hiscores[0].Name != "dave" || board.Hiscores[0].Rank != 1 {
+		t.Errorf("Unexpected hiscore board: %v", board.Hiscores)
+	}
+
+	c.Send(&packets.Packet_FinishedBrowsingHiscores{FinishedBrowsingHiscores: &packets.FinishedBrowsingHiscoresMessage{}})
+	servertest.Expect[*packets.Packet_Id](t, c)
+}
+
+func TestArenasFillUpAndTearDown(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	first := alice.Arena()
+	if first == nil {
+		t.Fatal("Expected alice to be assigned an arena")
+	}
+	first.MaxPlayers = 1
+
+	bob, _ := servertest.Join(t, hub, "bob")
+	if bob.Arena() == nil || bob.Arena() == first {
+		t.Fatal("Expected bob to be assigned a new arena since the first one is full")
+	}
+
+	bob.Send(&packets.Packet_ArenaListRequest{ArenaListRequest: &packets.ArenaListRequestMessage{}})
+	servertest.ExpectNone[*packets.Packet_ArenaList](t, bob, 100*time.Millisecond)
+
+	alice.Send(packets.NewDisconnect("logged out"))
+	alice.Send(&packets.Packet_ArenaListRequest{ArenaListRequest: &packets.ArenaListRequestMessage{}})
+
+	arenas := servertest.Expect[*packets.Packet_ArenaList](t, alice).ArenaList.Arenas
+	if len(arenas) != 1 || arenas[0].Id != bob.Arena().Id || arenas[0].Players != 1 {
+		t.Errorf("Expected only bob's arena to remain, got %v", arenas)
+	}
+	if _, exists := hub.Arenas.Get(first.Id); exists {
+		t.Errorf("Expected empty arena %d to be torn down", first.Id)
+	}
+}
+
+func TestLoginToFullArena(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+	alice.Arena().MaxPlayers = 1
+
+	bob := servertest.Connect(t, hub)
+	servertest.Register(t, bob, "bob")
+	bob.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "bob", Password: "bob", ArenaId: alice.Arena().Id},
+	})
+
+	servertest.ExpectDeny(t, bob)
+	if bob.Arena() != nil {
+		t.Errorf("Expected bob not to join an arena")
+	}
+}
+
+func TestPrivateArenaInviteAndKick(t *testing.T) {
+	hub := servertest.NewHub(t)
+	host := servertest.Host(t, hub, "host")
+
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{WorldSize: 1000, MaxSpores: 50, MaxPlayers: 2},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+
+	arena := host.Arena()
+	if arena == nil || arena.Id != created.ArenaId || arena.HostId() != host.Id() || arena.RecordHiscores {
+		t.Fatalf("Expected host to be in private arena %d", created.ArenaId)
+	}
+
+	// Private arenas are never auto-assigned or joinable by ID
+	stranger, _ := servertest.Join(t, hub, "stranger")
+	if stranger.Arena() == arena {
+		t.Fatal("Expected stranger not to be assigned the private arena")
+	}
+
+	guest := servertest.Connect(t, hub)
+	servertest.Register(t, guest, "guest")
+	guest.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "guest", Password: "guest", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, guest)
+
+	// Only the host may kick
+	guest.Send(packets.NewKickPlayer(host.Id()))
+	servertest.ExpectNone[*packets.Packet_KickPlayer](t, host, 100*time.Millisecond)
+
+	host.Send(packets.NewKickPlayer(guest.Id()))
+	servertest.Expect[*packets.Packet_KickPlayer](t, guest)
+	if guest.Arena() != nil {
+		t.Error("Expected guest to be removed from the arena")
+	}
+	if _, ok := guest.State().(*states.Connected); !ok {
+		t.Errorf("Expected guest to be back in the lobby, got state %s", guest.State().Name())
+	}
+
+	// And can't just come back in
+	guest.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "guest", Password: "guest", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectDeny(t, guest)
+
+	// Guests are kicked just the same while they're dead
+	dead := servertest.Connect(t, hub)
+	servertest.Register(t, dead, "dead")
+	dead.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "dead", Password: "dead", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, dead)
+	servertest.WaitFor(t, func() bool { return arena.SharedGameObjects.Players.Len() == 2 })
+
+	var x, y float64
+	withPlayer(t, host, func(player *objects.Player) {
+		player.Radius = 100
+		x, y = player.X, player.Y
+	})
+	withPlayer(t, dead, func(player *objects.Player) {
+		player.X, player.Y = x, y
+		player.SetProtectedUntil(time.Time{})
+	})
+	host.Send(&packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: dead.Id()}})
+	servertest.Expect[*packets.Packet_DeathSummary](t, dead)
+
+	host.Send(packets.NewKickPlayer(dead.Id()))
+	servertest.Expect[*packets.Packet_KickPlayer](t, dead)
+	if _, ok := dead.State().(*states.Connected); !ok {
+		t.Errorf("Expected the dead guest to be back in the lobby, got state %s", dead.State().Name())
+	}
+	if dead.Arena() != nil {
+		t.Error("Expected the dead guest to be removed from the arena")
+	}
+
+	// And while they're watching
+	watcher := servertest.Connect(t, hub)
+	servertest.Register(t, watcher, "watcher")
+	watcher.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "watcher", Password: "watcher", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, watcher)
+	watcher.Send(&packets.Packet_SpectateRequest{SpectateRequest: &packets.SpectateRequestMessage{}})
+	servertest.Expect[*packets.Packet_Spectating](t, watcher)
+
+	host.Send(packets.NewKickPlayer(watcher.Id()))
+	servertest.Expect[*packets.Packet_KickPlayer](t, watcher)
+	if _, ok := watcher.State().(*states.Connected); !ok {
+		t.Errorf("Expected the watching guest to be back in the lobby, got state %s", watcher.State().Name())
+	}
+
+	for username, kicked := range map[string]*servertest.Client{"dead": dead, "watcher": watcher} {
+		kicked.Send(&packets.Packet_LoginRequest{
+			LoginRequest: &packets.LoginRequestMessage{Username: username, Password: username, InviteCode: created.InviteCode},
+		})
+		servertest.ExpectDeny(t, kicked)
+
+		// Nor watch from the sidelines
+		kicked.Send(&packets.Packet_SpectateRequest{SpectateRequest: &packets.SpectateRequestMessage{InviteCode: created.InviteCode}})
+		servertest.ExpectDeny(t, kicked)
+	}
+
+	// Watching a private arena takes an account, so that kicks stick
+	anonymous := servertest.Connect(t, hub)
+	anonymous.Send(&packets.Packet_SpectateRequest{SpectateRequest: &packets.SpectateRequestMessage{InviteCode: created.InviteCode}})
+	servertest.ExpectDeny(t, anonymous)
+
+	// When the host leaves, the player who has been in the arena longest takes over, kicks and all
+	join := func(username string) *servertest.Client {
+		guest := servertest.Connect(t, hub)
+		servertest.Register(t, guest, username)
+		guest.Send(&packets.Packet_LoginRequest{
+			LoginRequest: &packets.LoginRequestMessage{Username: username, Password: username, InviteCode: created.InviteCode},
+		})
+		servertest.ExpectOk(t, guest)
+		return guest
+	}
+	heir := join("heir")
+	host.Send(packets.NewDisconnect("leaving"))
+	servertest.WaitFor(t, func() bool { return arena.HostId() == heir.Id() })
+
+	other := join("other")
+	heir.Send(packets.NewKickPlayer(other.Id()))
+	servertest.Expect[*packets.Packet_KickPlayer](t, other)
+	if other.Arena() != nil {
+		t.Error("Expected the new host to be able to kick")
+	}
+}
+
+func TestCreatingPrivateArenas(t *testing.T) {
+	hub := servertest.NewHub(t)
+	// Only players who log in can host
+	guest := servertest.Connect(t, hub)
+	servertest.Register(t, guest, "guest")
+	guest.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{},
+	})
+	servertest.ExpectDeny(t, guest)
+
+	host := servertest.Host(t, hub, "host")
+
+	// And only so many arenas at a time
+	created := 0
+	for range 10 {
+		host.Send(&packets.Packet_CreateArenaRequest{
+			CreateArenaRequest: &packets.CreateArenaRequestMessage{},
+		})
+		denied := false
+	response:
+		for {
+			packet, ok := host.Next(servertest.DefaultTimeout)
+			if !ok {
+				t.Fatal("Timed out waiting for a response to creating an arena")
+			}
+			switch packet.Msg.(type) {
+			case *packets.Packet_ArenaCreated:
+				break response
+			case *packets.Packet_DenyResponse:
+				denied = true
+				break response
+			}
+		}
+		if denied {
+			break
+		}
+		created++
+	}
+	if created == 0 || created >= 10 {
+		t.Errorf("Expected the host to be limited to a few arenas, created %d", created)
+	}
+}
+
+func TestSpectating(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+	arena := alice.Arena()
+	arena.MaxPlayers = 1
+
+	// Wait for alice to be moving about in the world
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	servertest.Expect[*packets.Packet_Player](t, alice)
+
+	watcher := servertest.Connect(t, hub)
+	watcher.Send(&packets.Packet_SpectateRequest{
+		SpectateRequest: &packets.SpectateRequestMessage{ArenaId: arena.Id, PlayerId: alice.Id()},
+	})
+	servertest.ExpectOk(t, watcher)
+
+	spectating := servertest.Expect[*packets.Packet_Spectating](t, watcher).Spectating
+	if spectating.ArenaId != arena.Id || spectating.PlayerId != alice.Id() {
+		t.Errorf("Expected to follow player %d in arena %d, got %v", alice.Id(), arena.Id, spectating)
+	}
+	if watcher.Arena() != arena || arena.Clients.Len() != 1 || arena.Spectators.Len() != 1 {
+		t.Fatal("Expected the watcher to spectate without taking up a player slot")
+	}
+
+	// The world stream reaches the spectator
+	if _, senderId := servertest.ExpectFrom[*packets.Packet_Player](t, watcher, servertest.DefaultTimeout); senderId != alice.Id() {
+		t.Errorf("Expected a player update from %d, got one from %d", alice.Id(), senderId)
+	}
+
+	// But spectators can't act on it
+	watcher.Send(packets.NewChat("hi"))
+	servertest.ExpectNone[*packets.Packet_Chat](t, alice, 100*time.Millisecond)
+
+	// Following a player who leaves goes back to free-roaming
+	alice.Send(packets.NewDisconnect("logged out"))
+	if spectating := servertest.Expect[*packets.Packet_Spectating](t, watcher).Spectating; spectating.PlayerId != 0 {
+		t.Errorf("Expected to free-roam after the player left, following %d instead", spectating.PlayerId)
+	}
+
+	watcher.Send(&packets.Packet_FinishedSpectating{FinishedSpectating: &packets.FinishedSpectatingMessage{}})
+	servertest.Expect[*packets.Packet_Id](t, watcher)
+	if _, exists := hub.Arenas.Get(arena.Id); exists {
+		t.Error("Expected the arena to be torn down once nobody is playing or watching")
+	}
+}
+
+func TestDeathAndRespawn(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+	bob, bobPlayer := servertest.Join(t, hub, "bob")
+
+	players := alice.Arena().SharedGameObjects.Players
+	servertest.WaitFor(t, func() bool { return players.Len() == 2 })
+
+	var x, y float64
+	withPlayer(t, alice, func(player *objects.Player) {
+		player.Radius = 100
+		x, y = player.X, player.Y
+	})
+	withPlayer(t, bob, func(player *objects.Player) {
+		player.X, player.Y = x, y
+		player.SetProtectedUntil(time.Time{})
+	})
+
+	alice.Send(&packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: bob.Id()}})
+
+	summary := servertest.Expect[*packets.Packet_DeathSummary](t, bob).DeathSummary
+	if summary.KillerId != alice.Id() || summary.KillerName != "alice" {
+		t.Errorf("Expected to be killed by alice, got %v", summary)
+	}
+	if _, ok := bob.State().(*states.Dead); !ok {
+		t.Fatalf("Expected bob to be dead, got state %s", bob.State().Name())
+	}
+
+	bob.Send(&packets.Packet_RespawnRequest{RespawnRequest: &packets.RespawnRequestMessage{}})
+
+	respawned := servertest.Expect[*packets.Packet_Player](t, bob).Player
+	if respawned.Name != "bob" || respawned.Color != bobPlayer.Color {
+		t.Errorf("Expected bob to respawn with his account's name and color, got %v", respawned)
+	}
+}
+
+func TestSplitAndMerge(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	players := alice.Arena().SharedGameObjects.Players
+	servertest.WaitFor(t, func() bool { return players.Len() == 1 })
+	player, _ := players.Get(alice.Id())
+
+	// Too small to split
+	alice.Send(&packets.Packet_Split{Split: &packets.SplitMessage{}})
+	if player.Cells.Len() != 0 {
+		t.Fatalf("Expected a new player not to be able to split, got %d cells", player.Cells.Len())
+	}
+
+	withPlayer(t, alice, func(player *objects.Player) { player.Radius = 50 })
+	alice.Send(&packets.Packet_Split{Split: &packets.SplitMessage{}})
+	if player.Cells.Len() != 1 {
+		t.Fatalf("Expected the player to split into two cells, got %d extra cells", player.Cells.Len())
+	}
+
+	withPlayer(t, alice, func(player *objects.Player) {
+		cell, _ := player.Cells.Get(1)
+		if math.Abs(cell.Radius-player.Radius) > 1e-9 || math.Abs(2*math.Pi*cell.Radius*cell.Radius-math.Pi*50*50) > 1e-6 {
+			t.Errorf("Expected the mass to be halved between both cells, got radii %f and %f", player.Radius, cell.Radius)
+		}
+	})
+
+	// Each cell is sent separately
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	for {
+		if update := servertest.Expect[*packets.Packet_Player](t, alice).Player; update.CellId == 1 {
+			break
+		}
+	}
+
+	// And merge back in after the cooldown
+	withPlayer(t, alice, func(player *objects.Player) {
+		cell, _ := player.Cells.Get(1)
+		cell.MergeAt = time.Now()
+		cell.X, cell.Y = player.X, player.Y
+	})
+	removed := servertest.Expect[*packets.Packet_CellRemoved](t, alice).CellRemoved
+	if removed.PlayerId != alice.Id() || removed.CellId != 1 {
+		t.Errorf("Expected cell 1 of player %d to be merged, got %v", alice.Id(), removed)
+	}
+	withPlayer(t, alice, func(player *objects.Player) {
+		if player.Cells.Len() != 0 || math.Abs(player.Radius-50) > 1e-9 {
+			t.Errorf("Expected the player to be back in one piece with radius 50, got %d extra cells and radius %f", player.Cells.Len(), player.Radius)
+		}
+	})
+
+	// Splitting against the edge of the world doesn't put the new cell outside it
+	size := alice.Arena().World.Size
+	withPlayer(t, alice, func(player *objects.Player) {
+		player.X, player.Y = size-player.Radius, 0
+		player.Direction = 0
+	})
+	alice.Send(&packets.Packet_Split{Split: &packets.SplitMessage{}})
+	withPlayer(t, alice, func(player *objects.Player) {
+		cell, exists := player.Cells.Get(2)
+		if !exists {
+			t.Fatal("Expected the player to split again")
+		}
+		if cell.X+cell.Radius > size+1e-9 {
+			t.Errorf("Expected the new cell to stay inside the world of size %f, got it at x %f with radius %f", size, cell.X, cell.Radius)
+		}
+	})
+
+	// When another player eats the main body, the remaining cell takes its place, and the meal only
+	// counts once even if their client reports it twice
+	bob, _ := servertest.Join(t, hub, "bob")
+	servertest.WaitFor(t, func() bool { return players.Len() == 2 })
+	var x, y, expectedMass float64
+	withPlayer(t, alice, func(player *objects.Player) {
+		cell, _ := player.Cells.Get(2)
+		cell.X, cell.Y = -size+cell.Radius, 0
+		player.SetProtectedUntil(time.Time{})
+		x, y = player.X, player.Y
+		expectedMass = player.Cell.Mass()
+	})
+	withPlayer(t, bob, func(hunter *objects.Player) {
+		hunter.Radius = 200
+		hunter.X, hunter.Y = x, y
+		expectedMass += hunter.Mass()
+	})
+
+	consumed := &packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: alice.Id()}}
+	bob.Send(consumed, consumed)
+
+	removed = servertest.Expect[*packets.Packet_CellRemoved](t, bob).CellRemoved
+	if removed.PlayerId != alice.Id() || removed.CellId != 2 {
+		t.Errorf("Expected cell 2 of player %d to take the place of the main body, got %v", alice.Id(), removed)
+	}
+	withPlayer(t, bob, func(hunter *objects.Player) {
+		if math.Abs(hunter.Mass()-expectedMass) > 1e-6 {
+			t.Errorf("Expected bob to have grown to %f mass, got %f", expectedMass, hunter.Mass())
+		}
+	})
+	withPlayer(t, alice, func(player *objects.Player) {
+		if player.Cells.Len() != 0 || player.X > 0 {
+			t.Errorf("Expected alice to carry on as the far cell alone, got %d extra cells at x %f", player.Cells.Len(), player.X)
+		}
+	})
+}
+
+func TestEjectMass(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	world := alice.Arena().SharedGameObjects
+	servertest.WaitFor(t, func() bool { return world.Players.Len() == 1 })
+	player, _ := world.Players.Get(alice.Id())
+	withPlayer(t, alice, func(player *objects.Player) { player.Radius = 50 })
+
+	alice.Send(&packets.Packet_EjectMass{EjectMass: &packets.EjectMassMessage{}})
+
+	ejected := servertest.Expect[*packets.Packet_Spore](t, alice).Spore
+	spore, exists := world.Spores.Get(ejected.Id)
+	if !exists || spore.DroppedBy != player {
+		t.Fatal("Expected the ejected spore to be dropped by the player")
+	}
+	withPlayer(t, alice, func(player *objects.Player) {
+		if player.Radius >= 50 {
+			t.Errorf("Expected ejecting to cost mass, radius is still %f", player.Radius)
+		}
+	})
+
+	// The owner can't immediately re-eat it
+	alice.Send(&packets.Packet_SporeConsumed{SporeConsumed: &packets.SporeConsumedMessage{SporeId: ejected.Id}})
+	if _, exists := world.Spores.Get(ejected.Id); !exists {
+		t.Error("Expected the player not to be able to re-eat their ejected spore straight away")
+	}
+
+	// The spore flies off in the direction of movement before coming to rest
+	servertest.WaitFor(t, func() bool {
+		spore, _ = world.Spores.Get(ejected.Id)
+		return spore.LaunchX == 0 && spore.LaunchY == 0
+	})
+	if spore.X-ejected.X < 100 {
+		t.Errorf("Expected the spore to travel along the direction of movement, moved from %f to %f", ejected.X, spore.X)
+	}
+}
+
+func TestHazardBurstsLargePlayer(t *testing.T) {
+	hub := servertest.NewHub(t, servertest.WithoutHazards())
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	world := alice.Arena().SharedGameObjects
+	servertest.WaitFor(t, func() bool { return world.Players.Len() == 1 })
+	player, _ := world.Players.Get(alice.Id())
+
+	// Small players can pass by hazards unharmed
+	x, y, _ := player.Position()
+	hazardId := world.Hazards.Add(&objects.Hazard{X: x, Y: y, Radius: server.HazardRadius})
+
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	servertest.ExpectNone[*packets.Packet_HazardConsumed](t, alice, 200*time.Millisecond)
+	if player.Cells.Len() != 0 {
+		t.Fatalf("Expected a small player not to be burst, got %d extra cells", player.Cells.Len())
+	}
+
+	// Large ones are burst into pieces
+	withPlayer(t, alice, func(player *objects.Player) {
+		player.Radius = 150
+		world.Hazards.Replace(hazardId, &objects.Hazard{X: player.X, Y: player.Y, Radius: server.HazardRadius})
+	})
+	for {
+		consumed := servertest.Expect[*packets.Packet_HazardConsumed](t, alice).HazardConsumed
+		if consumed.HazardId == hazardId {
+			break
+		}
+	}
+
+	if _, exists := world.Hazards.Get(hazardId); exists {
+		t.Error("Expected the hazard to be removed from the world")
+	}
+	if player.Cells.Len() < 7 {
+		t.Errorf("Expected the player to burst into 8 pieces, got %d extra cells", player.Cells.Len())
+	}
+}
+
+func TestSpeedFollowsMassAndBoost(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, spawned := servertest.Join(t, hub, "alice")
+
+	if spawned.Speed != server.DefaultSpeedCurve.BaseSpeed {
+		t.Errorf("Expected a new player to start at the base speed %f, got %f", server.DefaultSpeedCurve.BaseSpeed, spawned.Speed)
+	}
+
+	world := alice.Arena().SharedGameObjects
+	servertest.WaitFor(t, func() bool { return world.Players.Len() == 1 })
+	withPlayer(t, alice, func(player *objects.Player) { player.Radius = 100 })
+
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	slowed := servertest.Expect[*packets.Packet_Player](t, alice).Player.Speed
+	if slowed >= server.DefaultSpeedCurve.BaseSpeed {
+		t.Fatalf("Expected a massive player to be slower than the base speed, got %f", slowed)
+	}
+
+	// Smaller cells split off from the player move at their own, faster pace
+	var cellId uint64
+	withPlayer(t, alice, func(player *objects.Player) {
+		cellId = player.Cells.Add(&objects.Cell{Radius: 10, MergeAt: time.Now().Add(time.Hour)})
+	})
+	servertest.WaitFor(t, func() bool {
+		faster := false
+		withPlayer(t, alice, func(player *objects.Player) {
+			cell, _ := player.Cells.Get(cellId)
+			faster = cell.VelX > 1.5*player.Speed
+		})
+		return faster
+	})
+
+	alice.Send(&packets.Packet_Boost{Boost: &packets.BoostMessage{Active: true}})
+	var boosted *packets.PlayerMessage
+	for boosted == nil || boosted.Speed <= slowed {
+		boosted = servertest.Expect[*packets.Packet_Player](t, alice).Player
+	}
+	if boosted.Radius >= 100 {
+		t.Errorf("Expected boosting to cost mass, radius is still %f", boosted.Radius)
+	}
+}
+
+func TestLargePlayersDecay(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	world := alice.Arena().SharedGameObjects
+	servertest.WaitFor(t, func() bool { return world.Players.Len() == 1 })
+	player, _ := world.Players.Get(alice.Id())
+	withPlayer(t, alice, func(player *objects.Player) { player.Radius = 300 })
+
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+
+	// The lost mass comes back as spores around the player, which they can't eat straight away
+	for {
+		spore := servertest.Expect[*packets.Packet_Spore](t, alice).Spore
+		if dropped, _ := world.Spores.Get(spore.Id); dropped != nil && dropped.DroppedBy == player && dropped.Radius == 10 {
+			break
+		}
+	}
+	withPlayer(t, alice, func(player *objects.Player) {
+		if player.Radius >= 300 {
+			t.Errorf("Expected a large player to decay, radius is still %f", player.Radius)
+		}
+	})
+}
+
+func TestWorldBoundaries(t *testing.T) {
+	hub := servertest.NewHub(t)
+
+	// Players can't leave a rectangular world
+	alice, _ := servertest.Join(t, hub, "alice")
+	world := alice.Arena().World
+	servertest.WaitFor(t, func() bool { return alice.Arena().SharedGameObjects.Players.Len() == 1 })
+	withPlayer(t, alice, func(player *objects.Player) { player.X = world.Size - player.Radius })
+
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	moved := servertest.Expect[*packets.Packet_Player](t, alice).Player
+	if moved.X+moved.Radius > world.Size {
+		t.Errorf("Expected the player to stop at the edge of the world at %f, got to %f", world.Size, moved.X+moved.Radius)
+	}
+
+	// But wrap around a torus
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{WorldSize: 1000, WorldShape: packets.WorldShape_WORLD_TORUS},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+
+	torus := servertest.Expect[*packets.Packet_World](t, host).World
+	if torus.Shape != packets.WorldShape_WORLD_TORUS || torus.Size != 1000 {
+		t.Fatalf("Expected to be told the world is a torus of size 1000, got %v", torus)
+	}
+
+	servertest.WaitFor(t, func() bool { return host.Arena().SharedGameObjects.Players.Len() == 1 })
+	withPlayer(t, host, func(player *objects.Player) { player.X = 999 })
+
+	host.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	for {
+		if wrapped := servertest.Expect[*packets.Packet_Player](t, host).Player; wrapped.CellId == 0 && wrapped.X < 0 {
+			break
+		}
+	}
+}
+
+func TestMapLayout(t *testing.T) {
+	dataDir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dataDir, "maps"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	err := os.WriteFile(filepath.Join(dataDir, "maps", "cave.json"), []byte(`{
+		"name": "cave",
+		"shape": "circle",
+		"size": 1000,
+		"obstacles": [{"x": 100, "y": -500, "width": 50, "height": 1000}],
+		"spawn_zones": [{"x": -50, "y": -50, "width": 100, "height": 100}],
+		"spore_regions": [{"x": -500, "y": -100, "width": 200, "height": 200, "weight": 3}],
+		"speed_curve": {"base_speed": 200},
+		"mass_decay": {"return_as_spores": false}
+	}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A map laying out regions beyond the edge of its world is rejected
+	err = os.WriteFile(filepath.Join(dataDir, "maps", "stray.json"), []byte(`{
+		"name": "stray",
+		"size": 1000,
+		"spawn_zones": [{"x": 1200, "y": 0, "width": 100, "height": 100}]
+	}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hub := server.NewHub(dataDir)
+	go hub.Run()
+
+	if _, loaded := hub.Maps["stray"]; loaded {
+		t.Error("Expected the map with a spawn zone outside the world not to be loaded")
+	}
+
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{MapName: "cave", MaxSpores: 50},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+
+	layout := servertest.Expect[*packets.Packet_World](t, host).World
+	if layout.MapName != "cave" || layout.Shape != packets.WorldShape_WORLD_CIRCLE || len(layout.Obstacles) != 1 {
+		t.Fatalf("Expected to be told about the cave map, got %v", layout)
+	}
+
+	// Players spawn in the spawn zones, and spores grow in the spore regions
+	spawned := servertest.Expect[*packets.Packet_Player](t, host).Player
+	if math.Abs(spawned.X) > 50 || math.Abs(spawned.Y) > 50 {
+		t.Errorf("Expected the player to spawn in the spawn zone, got (%f, %f)", spawned.X, spawned.Y)
+	}
+
+	// The map tunes how players move and decay, keeping the defaults for what it leaves out
+	if spawned.Speed != 200 {
+		t.Errorf("Expected the map's base speed of 200, got %f", spawned.Speed)
+	}
+	if decay := host.Arena().MassDecay; decay.ReturnAsSpores || decay.Rate != server.DefaultMassDecay.Rate {
+		t.Errorf("Expected the map to stop decayed mass returning as spores and keep the default rate, got %+v", decay)
+	}
+	host.Arena().SharedGameObjects.Spores.ForEach(func(_ uint64, spore *objects.Spore) {
+		if spore.X < -500 || spore.X > -300 || spore.Y < -100 || spore.Y > 100 {
+			t.Errorf("Expected spores to grow in the spore region, got one at (%f, %f)", spore.X, spore.Y)
+		}
+	})
+
+	// And can't move through obstacles
+	host.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if moved := servertest.Expect[*packets.Packet_Player](t, host).Player; moved.X+moved.Radius > 100+1e-9 {
+			t.Fatalf("Expected the player to be stopped by the obstacle at x=100, got to %f", moved.X+moved.Radius)
+		}
+	}
+}
+
+func TestPowerUpCatalogValidation(t *testing.T) {
+	dataDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dataDir, "powerups.json"), []byte(`[
+		{"kind": "speed", "duration": 5, "strength": 0, "weight": 1}
+	]`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A speed power-up that would freeze players is rejected in favour of the defaults
+	hub := server.NewHub(dataDir)
+	if !slices.Equal(hub.PowerUps, server.DefaultPowerUps) {
+		t.Errorf("Expected the default power-ups, got %+v", hub.PowerUps)
+	}
+}
+
+func TestPowerUps(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	world := alice.Arena().SharedGameObjects
+	servertest.WaitFor(t, func() bool { return world.Players.Len() == 1 })
+	player, _ := world.Players.Get(alice.Id())
+	x, y, _ := player.Position()
+
+	world.PowerUps.ForEach(func(powerUpId uint64, _ *objects.PowerUp) { world.PowerUps.Remove(powerUpId) })
+	powerUpId := world.PowerUps.Add(&objects.PowerUp{X: x, Y: y, Radius: 15, Kind: objects.PowerUpSpeed})
+
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	collected := servertest.Expect[*packets.Packet_PowerUpCollected](t, alice).PowerUpCollected
+	if collected.PowerUpId != powerUpId || collected.Kind != objects.PowerUpSpeed || collected.DurationMs == 0 {
+		t.Fatalf("Expected to collect the speed power-up, got %v", collected)
+	}
+	if _, exists := world.PowerUps.Get(powerUpId); exists {
+		t.Error("Expected the power-up to be removed from the world")
+	}
+
+	// The effect is shown to everyone and makes the player faster
+	boosted := servertest.Expect[*packets.Packet_Player](t, alice).Player
+	if len(boosted.Effects) != 1 || boosted.Effects[0] != objects.PowerUpSpeed {
+		t.Errorf("Expected the player to show the speed effect, got %v", boosted.Effects)
+	}
+	for boosted.Speed <= server.DefaultSpeedCurve.BaseSpeed {
+		boosted = servertest.Expect[*packets.Packet_Player](t, alice).Player
+	}
+}
+
+func TestFoodTypes(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	world := alice.Arena().SharedGameObjects
+	servertest.WaitFor(t, func() bool { return world.Players.Len() == 1 })
+	var x, y float64
+	withPlayer(t, alice, func(player *objects.Player) {
+		player.Radius = 50
+		x, y = player.X, player.Y
+	})
+
+	mass := func() (mass float64) {
+		withPlayer(t, alice, func(player *objects.Player) { mass = radToMass(player.Radius) })
+		return mass
+	}
+	eat := func(kind string) float64 {
+		t.Helper()
+		before := mass()
+		sporeId := world.Spores.Add(&objects.Spore{X: x, Y: y, Radius: 10, Kind: kind})
+		alice.Send(&packets.Packet_SporeConsumed{SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId}})
+		if _, exists := world.Spores.Get(sporeId); exists {
+			servertest.WaitFor(t, func() bool { _, exists := world.Spores.Get(sporeId); return !exists })
+		}
+		return mass() - before
+	}
+
+	normal := eat(objects.SporeNormal)
+	if golden := eat(objects.SporeGolden); golden <= normal {
+		t.Errorf("Expected a golden spore to be worth more than a normal one (%f), got %f", normal, golden)
+	}
+	if poison := eat(objects.SporePoison); poison >= 0 {
+		t.Errorf("Expected a poison spore to shrink the player, got %f", poison)
+	}
+
+	// Prey runs away from players
+	prey := &objects.Spore{X: x + 100, Y: y, Radius: 10, Kind: objects.SporePrey}
+	preyId := world.Spores.Add(prey)
+	servertest.WaitFor(t, func() bool {
+		moved, exists := world.Spores.Get(preyId)
+		return exists && moved.X > prey.X+10
+	})
+}
+
+func radToMass(radius float64) float64 {
+	return math.Pi * radius * radius
+}
+
+// Run the function on the client's player in between the updates of their loop, failing the test if
+// the client isn't in game
+func withPlayer(t testing.TB, c *servertest.Client, do func(player *objects.Player)) {
+	t.Helper()
+
+	state := c.State()
+	inGame, ok := state.(*states.InGame)
+	if !ok {
+		t.Fatalf("Expected client %d to be in game, got %T", c.Id(), state)
+		return
+	}
+	inGame.WithPlayer(do)
+}
+
+func TestSpawnProtection(t *testing.T) {
+	hub := servertest.NewHub(t)
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{WorldSize: 1000},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+
+	players := host.Arena().SharedGameObjects.Players
+	servertest.WaitFor(t, func() bool { return players.Len() == 1 })
+	withPlayer(t, host, func(giant *objects.Player) { giant.X, giant.Y, giant.Radius = 0, 0, 150 })
+
+	// New players spawn well away from giants, and can't be eaten straight away
+	guest := servertest.Connect(t, hub)
+	servertest.Register(t, guest, "guest")
+	guest.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "guest", Password: "guest", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, guest)
+
+	spawned := servertest.Expect[*packets.Packet_Player](t, guest).Player
+	if !spawned.Protected {
+		t.Error("Expected a new player to be shown as protected")
+	}
+	if dist := math.Hypot(spawned.X, spawned.Y); dist < 3*150 {
+		t.Errorf("Expected the new player to spawn at a safe distance from the giant, got %f away", dist)
+	}
+
+	servertest.WaitFor(t, func() bool { return players.Len() == 2 })
+	withPlayer(t, guest, func(victim *objects.Player) { victim.X, victim.Y = 0, 0 })
+
+	host.Send(&packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: guest.Id()}})
+	servertest.ExpectNone[*packets.Packet_DeathSummary](t, guest, 100*time.Millisecond)
+
+	// Eating something ends the protection early
+	sporeId := host.Arena().SharedGameObjects.Spores.Add(&objects.Spore{X: 0, Y: 0, Radius: 5})
+	guest.Send(&packets.Packet_SporeConsumed{SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId}})
+	if victim, _ := players.Get(guest.Id()); victim.IsProtected() {
+		t.Fatal("Expected eating a spore to end the protection")
+	}
+
+	host.Send(&packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: guest.Id()}})
+	servertest.Expect[*packets.Packet_DeathSummary](t, guest)
+}
+
+func TestTeamsMode(t *testing.T) {
+	hub := servertest.NewHub(t)
+	host := servertest.Host(t, hub, "host")
+
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{Mode: "tag"},
+	})
+	servertest.ExpectDeny(t, host)
+
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{WorldSize: 1000, Mode: server.ModeTeams},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	join := func(c *servertest.Client, username string) *packets.PlayerMessage {
+		c.Send(&packets.Packet_LoginRequest{
+			LoginRequest: &packets.LoginRequestMessage{Username: username, Password: username, InviteCode: created.InviteCode},
+		})
+		servertest.ExpectOk(t, c)
+		player := servertest.Expect[*packets.Packet_Player](t, c).Player
+		if mode := servertest.Expect[*packets.Packet_GameMode](t, c).GameMode; mode.Name != server.ModeTeams {
+			t.Errorf("Expected to be told the arena plays teams, got %v", mode)
+		}
+		return player
+	}
+
+	hostPlayer := join(host, "host")
+	players := host.Arena().SharedGameObjects.Players
+	servertest.WaitFor(t, func() bool { return players.Len() == 1 })
+
+	// Players are spread evenly across the teams
+	rival := servertest.Connect(t, hub)
+	servertest.Register(t, rival, "rival")
+	rivalPlayer := join(rival, "rival")
+	servertest.WaitFor(t, func() bool { return players.Len() == 2 })
+	if rivalPlayer.Team == hostPlayer.Team {
+		t.Fatalf("Expected the second player to join the other team, got team %d", rivalPlayer.Team)
+	}
+
+	// And when the teams are even, newcomers go to the weaker one
+	var x, y float64
+	withPlayer(t, host, func(giant *objects.Player) {
+		giant.Radius = 150
+		x, y = giant.X, giant.Y
+	})
+
+	third := servertest.Connect(t, hub)
+	servertest.Register(t, third, "third")
+	thirdPlayer := join(third, "third")
+	servertest.WaitFor(t, func() bool { return players.Len() == 3 })
+	if thirdPlayer.Team != rivalPlayer.Team {
+		t.Fatalf("Expected the third player to join the weaker team %d, got team %d", rivalPlayer.Team, thirdPlayer.Team)
+	}
+
+	// Teammates can't eat each other
+	var rivalX, rivalY float64
+	withPlayer(t, rival, func(player *objects.Player) {
+		player.Radius = 100
+		rivalX, rivalY = player.X, player.Y
+	})
+	withPlayer(t, third, func(player *objects.Player) {
+		player.X, player.Y = rivalX, rivalY
+		player.SetProtectedUntil(time.Time{})
+	})
+
+	rival.Send(&packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: third.Id()}})
+	servertest.ExpectNone[*packets.Packet_DeathSummary](t, third, 100*time.Millisecond)
+
+	// But opponents can
+	withPlayer(t, third, func(player *objects.Player) {
+		player.X, player.Y = x, y
+	})
+
+	host.Send(&packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: third.Id()}})
+	servertest.Expect[*packets.Packet_DeathSummary](t, third)
+
+	standings := host.Arena().Mode.Standings()
+	if len(standings) != 2 || standings[0].Name != server.TeamName(hostPlayer.Team) {
+		t.Errorf("Expected the host's team to lead, got %v", standings)
+	}
+}
+
+func TestTeamPlay(t *testing.T) {
+	hub := servertest.NewHub(t, servertest.WithMap("field", `{"name": "field", "size": 1000, "max_hazards": 0}`))
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{MapName: "field", Mode: server.ModeTeams},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	players := make([]*servertest.Client, 4)
+	teams := make([]uint32, 4)
+	for i, username := range []string{"host", "b", "c", "d"} {
+		if i > 0 {
+			players[i] = servertest.Connect(t, hub)
+			servertest.Register(t, players[i], username)
+		} else {
+			players[i] = host
+		}
+		players[i].Send(&packets.Packet_LoginRequest{
+			LoginRequest: &packets.LoginRequestMessage{Username: username, Password: username, InviteCode: created.InviteCode},
+		})
+		servertest.ExpectOk(t, players[i])
+
+		// Players wear their team's color rather than their own
+		spawned := servertest.Expect[*packets.Packet_Player](t, players[i]).Player
+		if spawned.Color != server.TeamColor(spawned.Team) {
+			t.Errorf("Expected %s to wear the color of team %d, got %x", username, spawned.Team, spawned.Color)
+		}
+		teams[i] = spawned.Team
+		servertest.WaitFor(t, func() bool { return host.Arena().SharedGameObjects.Players.Len() == i+1 })
+	}
+
+	var mate, opponent *servertest.Client
+	for i, c := range players[1:] {
+		if teams[i+1] == teams[0] {
+			mate = c
+		} else {
+			opponent = c
+		}
+	}
+	if mate == nil || opponent == nil {
+		t.Fatal("Expected the host to have both teammates and opponents")
+	}
+
+	// Team chat only reaches teammates
+	host.Send(&packets.Packet_Chat{Chat: &packets.ChatMessage{Msg: "flank left", TeamOnly: true}})
+	if chat, _ := servertest.ExpectFrom[*packets.Packet_Chat](t, mate, servertest.DefaultTimeout); chat.Chat.Msg != "flank left" {
+		t.Errorf("Expected the teammate to get the team chat, got %q", chat.Chat.Msg)
+	}
+	servertest.ExpectNone[*packets.Packet_Chat](t, opponent, 100*time.Millisecond)
+
+	// Mass ejected near a teammate goes straight to them
+	var x, y, radiusBefore float64
+	withPlayer(t, host, func(player *objects.Player) {
+		player.Radius = 60
+		x, y = player.X, player.Y
+	})
+	withPlayer(t, mate, func(teammate *objects.Player) {
+		teammate.X, teammate.Y = x+100, y
+		teammate.PublishPositions()
+		radiusBefore = teammate.Radius
+	})
+
+	host.Send(&packets.Packet_EjectMass{EjectMass: &packets.EjectMassMessage{}})
+	shared := servertest.Expect[*packets.Packet_MassShare](t, mate).MassShare
+	if shared.PlayerId != mate.Id() || shared.Mass <= 0 {
+		t.Errorf("Expected the teammate to be told about the ejected mass, got %v", shared)
+	}
+
+	mate.Send(packets.NewPlayerDirection(0, 0))
+	update := servertest.Expect[*packets.Packet_Player](t, mate).Player
+	for update.Id != mate.Id() {
+		update = servertest.Expect[*packets.Packet_Player](t, mate).Player
+	}
+	if update.Radius <= radiusBefore {
+		t.Errorf("Expected the teammate to gain the ejected mass, still at radius %f", update.Radius)
+	}
+
+	// Clients can't share mass with themselves, or claim it from anyone else
+	withPlayer(t, host, func(player *objects.Player) { radiusBefore = player.Radius })
+	host.Send(&packets.Packet_MassShare{MassShare: &packets.MassShareMessage{PlayerId: host.Id(), Mass: 1e12}})
+	host.SendAs(mate.Id(), &packets.Packet_MassShare{MassShare: &packets.MassShareMessage{PlayerId: host.Id(), Mass: 1e12}})
+	withPlayer(t, host, func(player *objects.Player) {
+		if player.Radius > radiusBefore {
+			t.Errorf("Expected forged mass shares to be ignored, grew from radius %f to %f", radiusBefore, player.Radius)
+		}
+	})
+
+	// And the teams are kept up to date with the scores
+	scores := servertest.Expect[*packets.Packet_TeamScores](t, opponent).TeamScores.Teams
+	if len(scores) != 2 || scores[0].Players != 2 || scores[1].Players != 2 {
+		t.Errorf("Expected two teams of two on the scoreboard, got %v", scores)
+	}
+}
+
+func TestBattleRoyale(t *testing.T) {
+	hub := servertest.NewHub(t, servertest.WithMap("corner", `{
+		"name": "corner",
+		"size": 1000,
+		"spawn_zones": [{"x": 500, "y": 500, "width": 100, "height": 100}],
+		"round_time": 0.1
+	}`))
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{MapName: "corner", Mode: server.ModeBattleRoyale},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+	spawned := servertest.Expect[*packets.Packet_Player](t, host).Player
+
+	// The safe zone soon shrinks away from the corner the player spawned in, and being caught
+	// outside it drains them
+	zone := servertest.Expect[*packets.Packet_SafeZone](t, host).SafeZone
+	for zone.Radius > 200 {
+		zone = servertest.Expect[*packets.Packet_SafeZone](t, host).SafeZone
+	}
+
+	host.Send(packets.NewPlayerDirection(0, 0))
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		update := servertest.Expect[*packets.Packet_Player](t, host).Player
+		if update.Radius < spawned.Radius*0.85 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the player to be drained outside the safe zone, still at radius %f", update.Radius)
+		}
+	}
+}
+
+func TestTimedRounds(t *testing.T) {
+	hub := servertest.NewHub(t, servertest.WithMap("short", `{"name": "short", "size": 1000, "round_time": 2}`))
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{MapName: "short", Mode: server.ModeTimedRounds, RecordHiscores: true},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+	spawned := servertest.Expect[*packets.Packet_Player](t, host).Player
+
+	// Grow a little, so starting afresh shows
+	sporeId := host.Arena().SharedGameObjects.Spores.Add(&objects.Spore{X: spawned.X, Y: spawned.Y, Radius: 30})
+	host.Send(&packets.Packet_SporeConsumed{SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId}})
+
+	// Clients can't end the round for themselves
+	host.Send(&packets.Packet_RoundOver{RoundOver: &packets.RoundOverMessage{Winner: "host"}})
+	servertest.ExpectNone[*packets.Packet_RoundOver](t, host, 100*time.Millisecond)
+
+	host.Send(packets.NewPlayerDirection(0, 0))
+
+	roundOver, senderId := servertest.ExpectFrom[*packets.Packet_RoundOver](t, host, 3*time.Second)
+	if senderId != 0 || roundOver.RoundOver.Winner != "host" {
+		t.Errorf("Expected the arena to announce host as the winner, got %v from %d", roundOver.RoundOver, senderId)
+	}
+
+	// Everyone starts the next round afresh, with their score from the last one recorded
+	for {
+		update := servertest.Expect[*packets.Packet_Player](t, host).Player
+		if update.Radius <= spawned.Radius {
+			break
+		}
+	}
+	player, err := host.DbTx().Queries.GetPlayerByName(host.DbTx().Ctx, "host")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if player.BestScore <= int64(radToMass(spawned.Radius)) {
+		t.Errorf("Expected the round's score to be recorded, got a best score of %d", player.BestScore)
+	}
+}
+
+func TestInfectionMode(t *testing.T) {
+	hub := servertest.NewHub(t, servertest.WithMap("playground", `{"name": "playground", "size": 1000, "lobby_time": 0}`))
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{MapName: "playground", Mode: server.ModeInfection, RecordHiscores: true},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+	if mode := servertest.Expect[*packets.Packet_GameMode](t, host).GameMode; !mode.Lobby {
+		t.Errorf("Expected to wait in the lobby for more players, got %v", mode)
+	}
+
+	players := host.Arena().SharedGameObjects.Players
+	servertest.WaitFor(t, func() bool { return players.Len() == 1 })
+
+	guest := servertest.Connect(t, hub)
+	servertest.Register(t, guest, "guest")
+	guest.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "guest", Password: "guest", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, guest)
+
+	// Once there are enough players, one of them starts the round infected
+	var infected, healthy *objects.Player
+	servertest.WaitFor(t, func() bool {
+		infected, healthy = nil, nil
+		players.ForEach(func(_ uint64, player *objects.Player) {
+			switch player.Role() {
+			case objects.RoleInfected:
+				infected = player
+			case objects.RoleHealthy:
+				healthy = player
+			}
+		})
+		return infected != nil && healthy != nil
+	})
+
+	// Touching the healthy player infects them rather than eating them, and with nobody left
+	// healthy they win as the last survivor
+	healthyClient := host
+	if player, _ := players.Get(guest.Id()); player == healthy {
+		healthyClient = guest
+	}
+	x, y, _ := infected.Position()
+	withPlayer(t, healthyClient, func(player *objects.Player) { player.X, player.Y = x, y })
+	host.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	guest.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+
+	roundOver := servertest.Expect[*packets.Packet_RoundOver](t, host).RoundOver
+	if roundOver.Winner != healthy.Name {
+		t.Errorf("Expected %s to win as the last survivor, got %q", healthy.Name, roundOver.Winner)
+	}
+	if players.Len() != 2 {
+		t.Errorf("Expected nobody to be eaten, got %d players left", players.Len())
+	}
+
+	// The round is recorded in both players' match history, with both of them having ended it infected
+	for _, player := range []*objects.Player{infected, healthy} {
+		matches, err := host.DbTx().Queries.GetPlayerMatches(host.DbTx().Ctx, db.GetPlayerMatchesParams{PlayerID: player.DbId, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(matches) != 1 || matches[0].Mode != server.ModeInfection || matches[0].Winner != healthy.Name || matches[0].Role != objects.RoleInfected {
+			t.Errorf("Expected %s's match history to hold the round, got %+v", player.Name, matches)
+		}
+	}
+}
+
+func TestControlZones(t *testing.T) {
+	dataDir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dataDir, "maps"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	err := os.WriteFile(filepath.Join(dataDir, "maps", "hill.json"), []byte(`{
+		"name": "hill",
+		"size": 1000,
+		"spawn_zones": [{"x": -50, "y": -50, "width": 100, "height": 100}],
+		"control_zones": [{"x": -200, "y": -200, "width": 400, "height": 400}]
+	}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hub := server.NewHub(dataDir)
+	go hub.Run()
+
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{MapName: "hill", RecordHiscores: true},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+
+	if world := servertest.Expect[*packets.Packet_World](t, host).World; len(world.ControlZones) != 1 {
+		t.Fatalf("Expected to be told about the control zone, got %v", world.ControlZones)
+	}
+
+	// Sitting in the zone alone holds it and scores points over time
+	zone := servertest.Expect[*packets.Packet_Zone](t, host).Zone
+	if zone.State == packets.ZoneState_ZONE_NEUTRAL {
+		zone = servertest.Expect[*packets.Packet_Zone](t, host).Zone
+	}
+	if zone.State != packets.ZoneState_ZONE_HELD || zone.HolderId != host.Id() || zone.HolderName != "host" {
+		t.Errorf("Expected the host to hold the zone, got %v", zone)
+	}
+
+	host.Send(packets.NewPlayerDirection(0, 0))
+	for {
+		if update := servertest.Expect[*packets.Packet_Player](t, host).Player; update.ZonePoints >= 1 {
+			break
+		}
+	}
+
+	// The points are recorded on their own leaderboard
+	host.Send(packets.NewDisconnect("logged out"))
+	host.Send(&packets.Packet_HiscoreBoardRequest{HiscoreBoardRequest: &packets.HiscoreBoardRequestMessage{Category: "zones"}})
+
+	board := servertest.Expect[*packets.Packet_HiscoreBoard](t, host).HiscoreBoard
+	if board.Category != "zones" || len(board.Hiscores) != 1 || board.Hiscores[0].Name != "host" || board.Hiscores[0].Score < 1 {
+		t.Errorf("Expected the host to top the zones leaderboard, got %v", board)
+	}
+}
+
+func TestLiveLeaderboard(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+	bob, _ := servertest.Join(t, hub, "bob")
+
+	players := alice.Arena().SharedGameObjects.Players
+	servertest.WaitFor(t, func() bool { return players.Len() == 2 })
+	withPlayer(t, bob, func(big *objects.Player) { big.Radius = 100 })
+
+	// Everyone gets the top of the board, along with their own place on it
+	var board *packets.LeaderboardMessage
+	for board == nil || board.Players != 2 {
+		board = servertest.Expect[*packets.Packet_Leaderboard](t, alice).Leaderboard
+	}
+	if len(board.Entries) != 2 || board.Entries[0].PlayerId != bob.Id() || board.Entries[1].Name != "alice" {
+		t.Errorf("Expected bob to lead alice, got %v", board.Entries)
+	}
+	if board.OwnRank != 2 || board.OwnMass != board.Entries[1].Mass {
+		t.Errorf("Expected alice to be told their own rank is 2, got rank %d with mass %d", board.OwnRank, board.OwnMass)
+	}
+}
+
+func TestKillFeedAndAnnouncements(t *testing.T) {
+	hub := servertest.NewHub(t, servertest.WithoutHazards())
+	alice, _ := servertest.Join(t, hub, "alice")
+	bob, _ := servertest.Join(t, hub, "bob")
+	carol, _ := servertest.Join(t, hub, "carol")
+
+	players := alice.Arena().SharedGameObjects.Players
+	servertest.WaitFor(t, func() bool { return players.Len() == 3 })
+
+	var x, y, victimMass float64
+	withPlayer(t, alice, func(killer *objects.Player) {
+		killer.Radius = 100
+		killer.KillStreak = 2
+		x, y = killer.X, killer.Y
+	})
+	withPlayer(t, bob, func(victim *objects.Player) {
+		victim.X, victim.Y = x, y
+		victim.SetProtectedUntil(time.Time{})
+		victimMass = victim.Mass()
+	})
+
+	alice.Send(&packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: bob.Id()}})
+
+	// Everyone sees who ate whom
+	for _, c := range []*servertest.Client{alice, bob, carol} {
+		kill := servertest.Expect[*packets.Packet_KillFeed](t, c).KillFeed
+		if kill.KillerName != "alice" || kill.VictimName != "bob" || math.Abs(kill.Mass-victimMass) > 1e-6 {
+			t.Errorf("Expected alice to be shown eating bob's %f mass, got %v", victimMass, kill)
+		}
+	}
+
+	// And that was alice's third kill in a row
+	for {
+		announcement := servertest.Expect[*packets.Packet_Announcement](t, carol).Announcement
+		if announcement.Kind == server.AnnouncementKillStreak {
+			if announcement.PlayerId != alice.Id() {
+				t.Errorf("Expected alice's kill streak to be announced, got %v", announcement)
+			}
+			break
+		}
+	}
+}
+
+func TestBounty(t *testing.T) {
+	hub := servertest.NewHub(t, servertest.WithoutHazards())
+	alice, _ := servertest.Join(t, hub, "alice")
+	bob, _ := servertest.Join(t, hub, "bob")
+	carol, _ := servertest.Join(t, hub, "carol")
+
+	players := alice.Arena().SharedGameObjects.Players
+	servertest.WaitFor(t, func() bool { return players.Len() == 3 })
+
+	var x, y float64
+	withPlayer(t, alice, func(killer *objects.Player) {
+		killer.Radius = 100
+		killer.KillStreak = server.BountyKillStreak - 1
+		x, y = killer.X, killer.Y
+	})
+	withPlayer(t, bob, func(victim *objects.Player) {
+		victim.X, victim.Y = x, y
+		victim.SetProtectedUntil(time.Time{})
+	})
+
+	alice.Send(&packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: bob.Id()}})
+
+	// One more kill puts a bounty on alice, which everyone hears about
+	for {
+		announcement := servertest.Expect[*packets.Packet_Announcement](t, carol).Announcement
+		if announcement.Kind == server.AnnouncementBounty {
+			if announcement.PlayerId != alice.Id() {
+				t.Errorf("Expected the bounty to be on alice, got %v", announcement)
+			}
+			break
+		}
+	}
+
+	// And alice is flagged as the bounty holder in their updates
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+	for {
+		player, senderId := servertest.ExpectFrom[*packets.Packet_Player](t, carol, time.Second)
+		if senderId == alice.Id() && player.Player.Bounty > 0 {
+			break
+		}
+	}
+
+	// Whoever eats alice claims the bounty on top of their mass
+	var reward, expectedMass float64
+	withPlayer(t, alice, func(killer *objects.Player) {
+		killer.SetProtectedUntil(time.Time{})
+		x, y = killer.X, killer.Y
+		reward = math.Round(killer.Bounty())
+		expectedMass = killer.Mass() + reward
+	})
+	withPlayer(t, carol, func(hunter *objects.Player) {
+		hunter.Radius = 200
+		hunter.X, hunter.Y = x, y
+		expectedMass += hunter.Mass()
+	})
+
+	// Even if carol's client reports the kill twice
+	consumed := &packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: alice.Id()}}
+	carol.Send(consumed, consumed)
+
+	for {
+		announcement := servertest.Expect[*packets.Packet_Announcement](t, bob).Announcement
+		if announcement.Kind == server.AnnouncementBountyClaimed {
+			if announcement.PlayerId != carol.Id() {
+				t.Errorf("Expected carol to have claimed the bounty, got %v", announcement)
+			}
+			break
+		}
+	}
+
+	withPlayer(t, carol, func(hunter *objects.Player) {
+		if hunter.Mass() < expectedMass-reward/2 || hunter.Mass() > expectedMass+reward/2 {
+			t.Errorf("Expected carol to have grown to about %f mass, got %f", expectedMass, hunter.Mass())
+		}
+		if hunter.KillStreak != 1 {
+			t.Errorf("Expected the kill to count once, got a streak of %d", hunter.KillStreak)
+		}
+	})
+
+	// The claim goes down in carol's stats
+	hunter, _ := players.Get(carol.Id())
+	servertest.WaitFor(t, func() bool {
+		stats, err := carol.DbTx().Queries.GetPlayerStats(carol.DbTx().Ctx, hunter.DbId)
+		return err == nil && stats.BountiesClaimed == 1 && stats.BountyMassClaimed >= int64(reward)
+	})
+}
+
+func TestCombo(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	world := alice.Arena().SharedGameObjects
+	servertest.WaitFor(t, func() bool { return world.Players.Len() == 1 })
+	player, _ := world.Players.Get(alice.Id())
+	var x, y float64
+	withPlayer(t, alice, func(player *objects.Player) {
+		player.Radius = 50
+		x, y = player.X, player.Y
+	})
+	alice.Send(&packets.Packet_PlayerDirection{PlayerDirection: &packets.PlayerDirectionMessage{Direction: 0}})
+
+	mass := func() (mass float64) {
+		withPlayer(t, alice, func(player *objects.Player) { mass = radToMass(player.Radius) })
+		return mass
+	}
+	gains := make([]float64, 0, 3)
+	for i := 1; i <= 3; i++ {
+		before := mass()
+		sporeId := world.Spores.Add(&objects.Spore{X: x, Y: y, Radius: 10})
+		consumed := &packets.Packet_SporeConsumed{SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId}}
+		alice.Send(consumed)
+		if i == 1 {
+			// Reporting the same spore twice doesn't count it twice
+			alice.Send(consumed)
+		}
+
+		combo := servertest.Expect[*packets.Packet_Combo](t, alice).Combo
+		if combo.Combo != uint32(i) {
+			t.Fatalf("Expected a combo of %d, got %d", i, combo.Combo)
+		}
+		if i == 1 {
+			servertest.ExpectNone[*packets.Packet_Combo](t, alice, 100*time.Millisecond)
+		}
+		servertest.WaitFor(t, func() bool { _, exists := world.Spores.Get(sporeId); return !exists })
+		gains = append(gains, mass()-before)
+	}
+
+	// Each spore in the combo is worth more than the last
+	if gains[2] <= gains[1] || gains[1] <= gains[0] {
+		t.Errorf("Expected the mass gained to grow with the combo, got %v", gains)
+	}
+
+	// The combo runs out once the player stops eating
+	if combo := servertest.Expect[*packets.Packet_Combo](t, alice).Combo; combo.Combo != 0 || combo.Multiplier != 1 {
+		t.Errorf("Expected the combo to reset, got %v", combo)
+	}
+
+	// Spores players drop or eject don't count towards combos, or they could farm their own mass
+	dropped := &objects.Spore{X: x, Y: y, Radius: 10, DroppedBy: player, DroppedAt: time.Now().Add(-time.Minute)}
+	sporeId := world.Spores.Add(dropped)
+	alice.Send(&packets.Packet_SporeConsumed{SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId}})
+	servertest.WaitFor(t, func() bool { _, exists := world.Spores.Get(sporeId); return !exists })
+	servertest.ExpectNone[*packets.Packet_Combo](t, alice, 100*time.Millisecond)
+
+	// And the best one is kept
+	servertest.WaitFor(t, func() bool {
+		best, err := alice.DbTx().Queries.GetPlayerBestCombo(alice.DbTx().Ctx, player.DbId)
+		return err == nil && best == 3
+	})
+}
+
+func TestMomentum(t *testing.T) {
+	hub := servertest.NewHub(t)
+	alice, _ := servertest.Join(t, hub, "alice")
+
+	world := alice.Arena().SharedGameObjects
+	servertest.WaitFor(t, func() bool { return world.Players.Len() == 1 })
+
+	alice.Send(packets.NewPlayerDirection(0, 1))
+
+	// The player picks up speed gradually rather than all at once
+	first := servertest.Expect[*packets.Packet_Player](t, alice).Player
+	if first.VelX <= 0 || first.VelX >= first.Speed {
+		t.Errorf("Expected the player to be accelerating towards %f, got velocity %f", first.Speed, first.VelX)
+	}
+	for {
+		update := servertest.Expect[*packets.Packet_Player](t, alice).Player
+		if update.VelX > update.Speed*0.95 {
+			break
+		}
+	}
+
+	// And comes to a stop when they let go
+	alice.Send(packets.NewPlayerDirection(0, 0))
+	for {
+		update := servertest.Expect[*packets.Packet_Player](t, alice).Player
+		if math.Abs(update.VelX) < 1 {
+			break
+		}
+	}
+	player, _ := world.Players.Get(alice.Id())
+	x, _, _ := player.Position()
+	time.Sleep(200 * time.Millisecond)
+	if moved, _, _ := player.Position(); math.Abs(moved-x) > 1 {
+		t.Errorf("Expected the player to have stopped, moved from %f to %f", x, moved)
+	}
+}
+
+func TestWorldMassEconomy(t *testing.T) {
+	hub := servertest.NewHub(t)
+	host := servertest.Host(t, hub, "host")
+	host.Send(&packets.Packet_CreateArenaRequest{
+		CreateArenaRequest: &packets.CreateArenaRequestMessage{MaxSpores: 50},
+	})
+	created := servertest.Expect[*packets.Packet_ArenaCreated](t, host).ArenaCreated
+
+	host.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "host", Password: "host", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, host)
+
+	// Spores are spawned up to the world's mass budget, but never past the arena's limit
+	arena := host.Arena()
+	servertest.WaitFor(t, func() bool {
+		metrics := arena.WorldMass()
+		return metrics.Players == 1 && metrics.SporeMass >= metrics.TargetSporeMass*0.9
+	})
+	alone := arena.WorldMass()
+	if alone.Spores > arena.MaxSpores {
+		t.Errorf("Expected at most %d spores, got %d", arena.MaxSpores, alone.Spores)
+	}
+
+	// The budget grows with the population
+	guest := servertest.Connect(t, hub)
+	servertest.Register(t, guest, "guest")
+	guest.Send(&packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "guest", Password: "guest", InviteCode: created.InviteCode},
+	})
+	servertest.ExpectOk(t, guest)
+	servertest.WaitFor(t, func() bool { return arena.WorldMass().Players == 2 })
+	if together := arena.WorldMass(); together.TargetSporeMass <= alone.TargetSporeMass {
+		t.Errorf("Expected a bigger spore budget for two players than %f, got %f", alone.TargetSporeMass, together.TargetSporeMass)
+	}
+
+	// Spores dropped by players are cleared away once they've been around too long
+	player, _ := arena.SharedGameObjects.Players.Get(host.Id())
+	x, y, _ := player.Position()
+	sporeId := arena.SharedGameObjects.Spores.Add(&objects.Spore{
+		X:         x + 500,
+		Y:         y,
+		Radius:    10,
+		DroppedBy: player,
+		DroppedAt: time.Now().Add(-arena.SporeLifetime),
+	})
+	expired, senderId := servertest.ExpectFrom[*packets.Packet_SporesExpired](t, guest, servertest.DefaultTimeout)
+	if senderId != 0 || !slices.Contains(expired.SporesExpired.SporeIds, sporeId) {
+		t.Errorf("Expected spore %d to expire from the arena, got %v from %d", sporeId, expired.SporesExpired.SporeIds, senderId)
+	}
+	if _, exists := arena.SharedGameObjects.Spores.Get(sporeId); exists {
+		t.Error("Expected the dropped spore to have expired")
+	}
+	if metrics := arena.WorldMass(); metrics.SporesExpired != 1 {
+		t.Errorf("Expected 1 expired spore in the world's metrics, got %d", metrics.SporesExpired)
+	}
+
+	// Operators can read the metrics of every public arena, but not of private ones
+	public, _ := servertest.Join(t, hub, "public")
+	servertest.WaitFor(t, func() bool { return public.Arena().WorldMass().Players == 1 })
+
+	recorder := httptest.NewRecorder()
+	hub.ServeMetrics(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	served := map[uint64]server.MassMetrics{}
+	if err := json.NewDecoder(recorder.Body).Decode(&served); err != nil {
+		t.Fatalf("Expected the metrics as JSON: %v", err)
+	}
+	if metrics, exists := served[public.Arena().Id]; !exists || metrics.Players != 1 {
+		t.Errorf("Expected the public arena's metrics to be served, got %+v", served)
+	}
+	if _, exists := served[arena.Id]; exists {
+		t.Errorf("Expected the private arena's metrics to be left out, got %+v", served)
+	}
+}
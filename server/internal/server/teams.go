@@ -0,0 +1,121 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+// How often the team scoreboard is sent to clients
+const teamScoresSyncInterval = time.Second
+
+// The teams players are split into in the teams mode, numbered from 1 in this order
+var teamInfo = []struct {
+	Name  string
+	Color uint32
+}{
+	{Name: "Red", Color: 0xe74c3cff},
+	{Name: "Blue", Color: 0x3498dbff},
+}
+
+// TeamName returns the display name of the team with the given number
+func TeamName(team uint32) string {
+	if team < 1 || int(team) > len(teamInfo) {
+		return ""
+	}
+	return teamInfo[team-1].Name
+}
+
+// TeamColor returns the color every member of the team with the given number is shown in
+func TeamColor(team uint32) int32 {
+	if team < 1 || int(team) > len(teamInfo) {
+		return 0
+	}
+	return int32(teamInfo[team-1].Color)
+}
+
+// Players are split evenly into teams, who can't eat their own teammates and are scored together
+type teams struct {
+	freeForAll
+
+	// Guards when the scoreboard was last sent, which only the arena changes but joining players read
+	mux          sync.Mutex
+	lastSyncedAt time.Time
+}
+
+func (m *teams) Name() string {
+	return ModeTeams
+}
+
+func (m *teams) Status() []packets.Msg {
+	return []packets.Msg{packets.NewGameMode(ModeTeams, 0, false), packets.NewTeamScores(m.scores())}
+}
+
+// Put the player on whichever team has the fewest players, and of those the weakest, counting
+// both the mass of its members and how good they have proven to be in the past. The player takes
+// on the team's color for as long as they are on it.
+func (m *teams) OnSpawn(playerId uint64, player *objects.Player) {
+	sizes := make([]int, len(teamInfo)+1)
+	strengths := make([]float64, len(teamInfo)+1)
+	m.arena.SharedGameObjects.Players.ForEach(func(otherId uint64, other *objects.Player) {
+		if otherId != playerId && TeamName(other.Team) != "" {
+			sizes[other.Team]++
+			strengths[other.Team] += max(other.PublishedMass(), float64(other.BestScore))
+		}
+	})
+
+	player.Team = 1
+	for team := uint32(2); int(team) <= len(teamInfo); team++ {
+		if sizes[team] < sizes[player.Team] || sizes[team] == sizes[player.Team] && strengths[team] < strengths[player.Team] {
+			player.Team = team
+		}
+	}
+	player.Color = TeamColor(player.Team)
+}
+
+func (m *teams) Tick(delta float64) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if time.Since(m.lastSyncedAt) >= teamScoresSyncInterval {
+		m.lastSyncedAt = time.Now()
+		go m.arena.Broadcast(&packets.Packet{SenderId: 0, Msg: packets.NewTeamScores(m.scores())})
+	}
+}
+
+// Friendly fire is off
+func (m *teams) CanConsume(eater *objects.Player, victim *objects.Player) bool {
+	return eater.Team != victim.Team
+}
+
+func (m *teams) Standings() []*packets.StandingMessage {
+	scores := m.scores()
+	standings := make([]*packets.StandingMessage, len(scores))
+	for i, score := range scores {
+		standings[i] = &packets.StandingMessage{Name: score.Name, Score: score.Score}
+	}
+	sortStandings(standings)
+	return standings
+}
+
+// The scoreboard of every team, in team order
+func (m *teams) scores() []*packets.TeamScoreMessage {
+	scores := make([]*packets.TeamScoreMessage, len(teamInfo))
+	for i, info := range teamInfo {
+		scores[i] = &packets.TeamScoreMessage{
+			Team:  uint32(i + 1),
+			Name:  info.Name,
+			Color: int32(info.Color),
+		}
+	}
+
+	m.arena.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		if TeamName(player.Team) != "" {
+			scores[player.Team-1].Players++
+			scores[player.Team-1].Score += uint64(math.Round(player.PublishedMass()))
+		}
+	})
+	return scores
+}
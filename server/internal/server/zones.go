@@ -0,0 +1,139 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+const (
+	// A zone is contested rather than held while the runner-up has at least this share of the
+	// leader's mass inside it
+	zoneContestRatio = 0.8
+
+	// How many points the holder of a zone scores per second
+	zonePointsRate = 1.0
+)
+
+// A map-defined area players compete to hold for points
+type controlZone struct {
+	objects.Region
+	State    packets.ZoneState
+	HolderId uint64
+}
+
+// The arena's control zones, which its zone loop updates while joining players read them
+type controlZones struct {
+	mux   sync.Mutex
+	zones []*controlZone
+}
+
+// Set up a neutral zone for each of the world's control zones
+func newControlZones(world *objects.World) *controlZones {
+	zones := make([]*controlZone, len(world.ControlZones))
+	for i, region := range world.ControlZones {
+		zones[i] = &controlZone{Region: region}
+	}
+	return &controlZones{zones: zones}
+}
+
+// ZoneStatus returns the current state of each of the arena's control zones
+func (a *Arena) ZoneStatus() []packets.Msg {
+	a.controlZones.mux.Lock()
+	defer a.controlZones.mux.Unlock()
+
+	msgs := make([]packets.Msg, len(a.controlZones.zones))
+	for i, zone := range a.controlZones.zones {
+		msgs[i] = packets.NewZone(uint32(i), zone.State, zone.HolderId, a.playerName(zone.HolderId))
+	}
+	return msgs
+}
+
+func (a *Arena) zonesLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	lastTick := time.Now()
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			delta := now.Sub(lastTick).Seconds()
+			lastTick = now
+			a.updateZones(delta)
+		case <-a.ctx.Done():
+			return
+		}
+	}
+}
+
+// Work out who holds each zone, award them their points, and tell everyone about any zone that
+// changed hands
+func (a *Arena) updateZones(delta float64) {
+	for _, msg := range a.holdZones(delta) {
+		a.Broadcast(&packets.Packet{SenderId: 0, Msg: msg})
+	}
+}
+
+// Update who holds each zone, returning the messages announcing any changes. The announcements are
+// left to the caller so that joining players aren't kept waiting for the zones while they're sent.
+func (a *Arena) holdZones(delta float64) []packets.Msg {
+	a.controlZones.mux.Lock()
+	defer a.controlZones.mux.Unlock()
+
+	changes := make([]packets.Msg, 0)
+	for i, zone := range a.controlZones.zones {
+		var leaderId uint64
+		var leader *objects.Player
+		leaderMass, runnerUpMass := 0.0, 0.0
+
+		a.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+			mass := massInRegion(player, zone.Region)
+			if mass <= 0 {
+				return
+			}
+			if mass > leaderMass {
+				leaderId, leader, leaderMass, runnerUpMass = playerId, player, mass, leaderMass
+			} else if mass > runnerUpMass {
+				runnerUpMass = mass
+			}
+		})
+
+		state, holderId := packets.ZoneState_ZONE_HELD, leaderId
+		if leader == nil {
+			state, holderId = packets.ZoneState_ZONE_NEUTRAL, 0
+		} else if runnerUpMass >= leaderMass*zoneContestRatio {
+			state, holderId = packets.ZoneState_ZONE_CONTESTED, 0
+		}
+
+		if state == packets.ZoneState_ZONE_HELD {
+			leader.AwardZonePoints(zonePointsRate * delta)
+		}
+
+		if state != zone.State || holderId != zone.HolderId {
+			zone.State, zone.HolderId = state, holderId
+			changes = append(changes, packets.NewZone(uint32(i), state, holderId, a.playerName(holderId)))
+		}
+	}
+	return changes
+}
+
+// Returns the name of the player with the given ID, or an empty string if there's no such player
+func (a *Arena) playerName(playerId uint64) string {
+	if player, exists := a.SharedGameObjects.Players.Get(playerId); exists {
+		return player.Name
+	}
+	return ""
+}
+
+// The total mass of the player's cells whose centres are inside the region, as of their last update
+func massInRegion(player *objects.Player, region objects.Region) float64 {
+	mass := 0.0
+	for _, cell := range player.Positions() {
+		if region.Contains(cell.X, cell.Y) {
+			mass += cell.Mass()
+		}
+	}
+	return mass
+}
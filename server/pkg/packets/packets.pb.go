@@ -20,12 +20,112 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type WorldShape int32
+
+const (
+	WorldShape_WORLD_RECT   WorldShape = 0
+	WorldShape_WORLD_CIRCLE WorldShape = 1
+	WorldShape_WORLD_TORUS  WorldShape = 2
+)
+
+// Enum value maps for WorldShape.
+var (
+	WorldShape_name = map[int32]string{
+		0: "WORLD_RECT",
+		1: "WORLD_CIRCLE",
+		2: "WORLD_TORUS",
+	}
+	WorldShape_value = map[string]int32{
+		"WORLD_RECT":   0,
+		"WORLD_CIRCLE": 1,
+		"WORLD_TORUS":  2,
+	}
+)
+
+func (x WorldShape) Enum() *WorldShape {
+	p := new(WorldShape)
+	*p = x
+	return p
+}
+
+func (x WorldShape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorldShape) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[0].Descriptor()
+}
+
+func (WorldShape) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[0]
+}
+
+func (x WorldShape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorldShape.Descriptor instead.
+func (WorldShape) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{0}
+}
+
+type ZoneState int32
+
+const (
+	ZoneState_ZONE_NEUTRAL   ZoneState = 0
+	ZoneState_ZONE_CONTESTED ZoneState = 1
+	ZoneState_ZONE_HELD      ZoneState = 2
+)
+
+// Enum value maps for ZoneState.
+var (
+	ZoneState_name = map[int32]string{
+		0: "ZONE_NEUTRAL",
+		1: "ZONE_CONTESTED",
+		2: "ZONE_HELD",
+	}
+	ZoneState_value = map[string]int32{
+		"ZONE_NEUTRAL":   0,
+		"ZONE_CONTESTED": 1,
+		"ZONE_HELD":      2,
+	}
+)
+
+func (x ZoneState) Enum() *ZoneState {
+	p := new(ZoneState)
+	*p = x
+	return p
+}
+
+func (x ZoneState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ZoneState) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[1].Descriptor()
+}
+
+func (ZoneState) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[1]
+}
+
+func (x ZoneState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ZoneState.Descriptor instead.
+func (ZoneState) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{1}
+}
+
 type ChatMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Msg      string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	TeamOnly bool   `protobuf:"varint,2,opt,name=team_only,json=teamOnly,proto3" json:"team_only,omitempty"`
+	Team     uint32 `protobuf:"varint,3,opt,name=team,proto3" json:"team,omitempty"`
 }
 
 func (x *ChatMessage) Reset() {
@@ -65,6 +165,20 @@ func (x *ChatMessage) GetMsg() string {
 	return ""
 }
 
+func (x *ChatMessage) GetTeamOnly() bool {
+	if x != nil {
+		return x.TeamOnly
+	}
+	return false
+}
+
+func (x *ChatMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
 type IdMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -115,8 +229,10 @@ type LoginRequestMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Username   string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password   string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ArenaId    uint64 `protobuf:"varint,3,opt,name=arena_id,json=arenaId,proto3" json:"arena_id,omitempty"`
+	InviteCode string `protobuf:"bytes,4,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
 }
 
 func (x *LoginRequestMessage) Reset() {
@@ -163,6 +279,20 @@ func (x *LoginRequestMessage) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequestMessage) GetArenaId() uint64 {
+	if x != nil {
+		return x.ArenaId
+	}
+	return 0
+}
+
+func (x *LoginRequestMessage) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
 type RegisterRequestMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -310,14 +440,23 @@ type PlayerMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	X         float64 `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
-	Y         float64 `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
-	Radius    float64 `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
-	Direction float64 `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
-	Speed     float64 `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
-	Color     int32   `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	Id         uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	X          float64  `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y          float64  `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
+	Radius     float64  `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
+	Direction  float64  `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	Speed      float64  `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
+	Color      int32    `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	CellId     uint64   `protobuf:"varint,9,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	Effects    []string `protobuf:"bytes,10,rep,name=effects,proto3" json:"effects,omitempty"`
+	Protected  bool     `protobuf:"varint,11,opt,name=protected,proto3" json:"protected,omitempty"`
+	Team       uint32   `protobuf:"varint,12,opt,name=team,proto3" json:"team,omitempty"`
+	Role       string   `protobuf:"bytes,13,opt,name=role,proto3" json:"role,omitempty"`
+	ZonePoints uint64   `protobuf:"varint,14,opt,name=zone_points,json=zonePoints,proto3" json:"zone_points,omitempty"`
+	Bounty     uint64   `protobuf:"varint,15,opt,name=bounty,proto3" json:"bounty,omitempty"`
+	VelX       float64  `protobuf:"fixed64,16,opt,name=vel_x,json=velX,proto3" json:"vel_x,omitempty"`
+	VelY       float64  `protobuf:"fixed64,17,opt,name=vel_y,json=velY,proto3" json:"vel_y,omitempty"`
 }
 
 func (x *PlayerMessage) Reset() {
@@ -406,12 +545,79 @@ func (x *PlayerMessage) GetColor() int32 {
 	return 0
 }
 
+func (x *PlayerMessage) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetEffects() []string {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+func (x *PlayerMessage) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *PlayerMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PlayerMessage) GetZonePoints() uint64 {
+	if x != nil {
+		return x.ZonePoints
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetBounty() uint64 {
+	if x != nil {
+		return x.Bounty
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetVelX() float64 {
+	if x != nil {
+		return x.VelX
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetVelY() float64 {
+	if x != nil {
+		return x.VelY
+	}
+	return 0
+}
+
 type PlayerDirectionMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Direction float64 `protobuf:"fixed64,1,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Types that are assignable to Throttle:
+	//
+	//	*PlayerDirectionMessage_Intensity
+	Throttle isPlayerDirectionMessage_Throttle `protobuf_oneof:"throttle"`
 }
 
 func (x *PlayerDirectionMessage) Reset() {
@@ -451,6 +657,30 @@ func (x *PlayerDirectionMessage) GetDirection() float64 {
 	return 0
 }
 
+func (m *PlayerDirectionMessage) GetThrottle() isPlayerDirectionMessage_Throttle {
+	if m != nil {
+		return m.Throttle
+	}
+	return nil
+}
+
+func (x *PlayerDirectionMessage) GetIntensity() float64 {
+	if x, ok := x.GetThrottle().(*PlayerDirectionMessage_Intensity); ok {
+		return x.Intensity
+	}
+	return 0
+}
+
+type isPlayerDirectionMessage_Throttle interface {
+	isPlayerDirectionMessage_Throttle()
+}
+
+type PlayerDirectionMessage_Intensity struct {
+	Intensity float64 `protobuf:"fixed64,2,opt,name=intensity,proto3,oneof"`
+}
+
+func (*PlayerDirectionMessage_Intensity) isPlayerDirectionMessage_Throttle() {}
+
 type SporeMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -460,6 +690,7 @@ type SporeMessage struct {
 	X      float64 `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
 	Y      float64 `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
 	Radius float64 `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Kind   string  `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
 }
 
 func (x *SporeMessage) Reset() {
@@ -520,12 +751,20 @@ func (x *SporeMessage) GetRadius() float64 {
 	return 0
 }
 
+func (x *SporeMessage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
 type SporeConsumedMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SporeId uint64 `protobuf:"varint,1,opt,name=spore_id,json=sporeId,proto3" json:"spore_id,omitempty"`
+	CellId  uint64 `protobuf:"varint,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
 }
 
 func (x *SporeConsumedMessage) Reset() {
@@ -565,6 +804,13 @@ func (x *SporeConsumedMessage) GetSporeId() uint64 {
 	return 0
 }
 
+func (x *SporeConsumedMessage) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
 type SporesBatchMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -615,7 +861,9 @@ type PlayerConsumedMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerId uint64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerId    uint64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	CellId      uint64 `protobuf:"varint,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	EaterCellId uint64 `protobuf:"varint,3,opt,name=eater_cell_id,json=eaterCellId,proto3" json:"eater_cell_id,omitempty"`
 }
 
 func (x *PlayerConsumedMessage) Reset() {
@@ -655,10 +903,26 @@ func (x *PlayerConsumedMessage) GetPlayerId() uint64 {
 	return 0
 }
 
+func (x *PlayerConsumedMessage) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *PlayerConsumedMessage) GetEaterCellId() uint64 {
+	if x != nil {
+		return x.EaterCellId
+	}
+	return 0
+}
+
 type HiscoreBoardRequestMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 }
 
 func (x *HiscoreBoardRequestMessage) Reset() {
@@ -691,6 +955,13 @@ func (*HiscoreBoardRequestMessage) Descriptor() ([]byte, []int) {
 	return file_packets_proto_rawDescGZIP(), []int{12}
 }
 
+func (x *HiscoreBoardRequestMessage) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type HiscoreMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -758,6 +1029,7 @@ type HiscoreBoardMessage struct {
 	unknownFields protoimpl.UnknownFields
 
 	Hiscores []*HiscoreMessage `protobuf:"bytes,1,rep,name=hiscores,proto3" json:"hiscores,omitempty"`
+	Category string            `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
 }
 
 func (x *HiscoreBoardMessage) Reset() {
@@ -797,6 +1069,13 @@ func (x *HiscoreBoardMessage) GetHiscores() []*HiscoreMessage {
 	return nil
 }
 
+func (x *HiscoreBoardMessage) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type FinishedBrowsingHiscoresMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -923,49 +1202,26 @@ func (x *DisconnectMessage) GetReason() string {
 	return ""
 }
 
-type Packet struct {
+type ArenaListRequestMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SenderId uint64 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Types that are assignable to Msg:
-	//
-	//	*Packet_Chat
-	//	*Packet_Id
-	//	*Packet_LoginRequest
-	//	*Packet_RegisterRequest
-	//	*Packet_OkResponse
-	//	*Packet_DenyResponse
-	//	*Packet_Player
-	//	*Packet_PlayerDirection
-	//	*Packet_Spore
-	//	*Packet_SporeConsumed
-	//	*Packet_SporesBatch
-	//	*Packet_PlayerConsumed
-	//	*Packet_HiscoreBoardRequest
-	//	*Packet_Hiscore
-	//	*Packet_HiscoreBoard
-	//	*Packet_FinishedBrowsingHiscores
-	//	*Packet_SearchHiscore
-	//	*Packet_Disconnect
-	Msg isPacket_Msg `protobuf_oneof:"msg"`
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
+func (x *ArenaListRequestMessage) Reset() {
+	*x = ArenaListRequestMessage{}
 	mi := &file_packets_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) String() string {
+func (x *ArenaListRequestMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*ArenaListRequestMessage) ProtoMessage() {}
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
+func (x *ArenaListRequestMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_packets_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,75 +1233,2186 @@ func (x *Packet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArenaListRequestMessage.ProtoReflect.Descriptor instead.
+func (*ArenaListRequestMessage) Descriptor() ([]byte, []int) {
 	return file_packets_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Packet) GetSenderId() uint64 {
-	if x != nil {
-		return x.SenderId
-	}
-	return 0
+type ArenaMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Players    uint64 `protobuf:"varint,2,opt,name=players,proto3" json:"players,omitempty"`
+	MaxPlayers uint64 `protobuf:"varint,3,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	Spectators uint64 `protobuf:"varint,4,opt,name=spectators,proto3" json:"spectators,omitempty"`
+	MapName    string `protobuf:"bytes,5,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	Mode       string `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-func (m *Packet) GetMsg() isPacket_Msg {
-	if m != nil {
-		return m.Msg
-	}
-	return nil
+func (x *ArenaMessage) Reset() {
+	*x = ArenaMessage{}
+	mi := &file_packets_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) GetChat() *ChatMessage {
-	if x, ok := x.GetMsg().(*Packet_Chat); ok {
-		return x.Chat
+func (x *ArenaMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArenaMessage) ProtoMessage() {}
+
+func (x *ArenaMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Packet) GetId() *IdMessage {
-	if x, ok := x.GetMsg().(*Packet_Id); ok {
+// Deprecated: Use ArenaMessage.ProtoReflect.Descriptor instead.
+func (*ArenaMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ArenaMessage) GetId() uint64 {
+	if x != nil {
 		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetLoginRequest() *LoginRequestMessage {
-	if x, ok := x.GetMsg().(*Packet_LoginRequest); ok {
-		return x.LoginRequest
+func (x *ArenaMessage) GetPlayers() uint64 {
+	if x != nil {
+		return x.Players
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
-	if x, ok := x.GetMsg().(*Packet_RegisterRequest); ok {
-		return x.RegisterRequest
+func (x *ArenaMessage) GetMaxPlayers() uint64 {
+	if x != nil {
+		return x.MaxPlayers
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetOkResponse() *OkResponseMessage {
-	if x, ok := x.GetMsg().(*Packet_OkResponse); ok {
-		return x.OkResponse
+func (x *ArenaMessage) GetSpectators() uint64 {
+	if x != nil {
+		return x.Spectators
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetDenyResponse() *DenyResponseMessage {
-	if x, ok := x.GetMsg().(*Packet_DenyResponse); ok {
-		return x.DenyResponse
+func (x *ArenaMessage) GetMapName() string {
+	if x != nil {
+		return x.MapName
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetPlayer() *PlayerMessage {
-	if x, ok := x.GetMsg().(*Packet_Player); ok {
-		return x.Player
+func (x *ArenaMessage) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+type ArenaListMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Arenas []*ArenaMessage `protobuf:"bytes,1,rep,name=arenas,proto3" json:"arenas,omitempty"`
+}
+
+func (x *ArenaListMessage) Reset() {
+	*x = ArenaListMessage{}
+	mi := &file_packets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArenaListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArenaListMessage) ProtoMessage() {}
+
+func (x *ArenaListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArenaListMessage.ProtoReflect.Descriptor instead.
+func (*ArenaListMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ArenaListMessage) GetArenas() []*ArenaMessage {
+	if x != nil {
+		return x.Arenas
+	}
+	return nil
+}
+
+type CreateArenaRequestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorldSize      float64    `protobuf:"fixed64,1,opt,name=world_size,json=worldSize,proto3" json:"world_size,omitempty"`
+	MaxSpores      uint64     `protobuf:"varint,2,opt,name=max_spores,json=maxSpores,proto3" json:"max_spores,omitempty"`
+	MaxPlayers     uint64     `protobuf:"varint,3,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	RecordHiscores bool       `protobuf:"varint,4,opt,name=record_hiscores,json=recordHiscores,proto3" json:"record_hiscores,omitempty"`
+	WorldShape     WorldShape `protobuf:"varint,5,opt,name=world_shape,json=worldShape,proto3,enum=packets.WorldShape" json:"world_shape,omitempty"`
+	MapName        string     `protobuf:"bytes,6,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	Mode           string     `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *CreateArenaRequestMessage) Reset() {
+	*x = CreateArenaRequestMessage{}
+	mi := &file_packets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArenaRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArenaRequestMessage) ProtoMessage() {}
+
+func (x *CreateArenaRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArenaRequestMessage.ProtoReflect.Descriptor instead.
+func (*CreateArenaRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateArenaRequestMessage) GetWorldSize() float64 {
+	if x != nil {
+		return x.WorldSize
+	}
+	return 0
+}
+
+func (x *CreateArenaRequestMessage) GetMaxSpores() uint64 {
+	if x != nil {
+		return x.MaxSpores
+	}
+	return 0
+}
+
+func (x *CreateArenaRequestMessage) GetMaxPlayers() uint64 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *CreateArenaRequestMessage) GetRecordHiscores() bool {
+	if x != nil {
+		return x.RecordHiscores
+	}
+	return false
+}
+
+func (x *CreateArenaRequestMessage) GetWorldShape() WorldShape {
+	if x != nil {
+		return x.WorldShape
+	}
+	return WorldShape_WORLD_RECT
+}
+
+func (x *CreateArenaRequestMessage) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *CreateArenaRequestMessage) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type ArenaCreatedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArenaId    uint64 `protobuf:"varint,1,opt,name=arena_id,json=arenaId,proto3" json:"arena_id,omitempty"`
+	InviteCode string `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+}
+
+func (x *ArenaCreatedMessage) Reset() {
+	*x = ArenaCreatedMessage{}
+	mi := &file_packets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArenaCreatedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArenaCreatedMessage) ProtoMessage() {}
+
+func (x *ArenaCreatedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArenaCreatedMessage.ProtoReflect.Descriptor instead.
+func (*ArenaCreatedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ArenaCreatedMessage) GetArenaId() uint64 {
+	if x != nil {
+		return x.ArenaId
+	}
+	return 0
+}
+
+func (x *ArenaCreatedMessage) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type KickPlayerMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId uint64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *KickPlayerMessage) Reset() {
+	*x = KickPlayerMessage{}
+	mi := &file_packets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickPlayerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerMessage) ProtoMessage() {}
+
+func (x *KickPlayerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerMessage.ProtoReflect.Descriptor instead.
+func (*KickPlayerMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *KickPlayerMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type SpectateRequestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArenaId    uint64 `protobuf:"varint,1,opt,name=arena_id,json=arenaId,proto3" json:"arena_id,omitempty"`
+	InviteCode string `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	PlayerId   uint64 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *SpectateRequestMessage) Reset() {
+	*x = SpectateRequestMessage{}
+	mi := &file_packets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRequestMessage) ProtoMessage() {}
+
+func (x *SpectateRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRequestMessage.ProtoReflect.Descriptor instead.
+func (*SpectateRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SpectateRequestMessage) GetArenaId() uint64 {
+	if x != nil {
+		return x.ArenaId
+	}
+	return 0
+}
+
+func (x *SpectateRequestMessage) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *SpectateRequestMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type SpectatingMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArenaId  uint64 `protobuf:"varint,1,opt,name=arena_id,json=arenaId,proto3" json:"arena_id,omitempty"`
+	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *SpectatingMessage) Reset() {
+	*x = SpectatingMessage{}
+	mi := &file_packets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatingMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatingMessage) ProtoMessage() {}
+
+func (x *SpectatingMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatingMessage.ProtoReflect.Descriptor instead.
+func (*SpectatingMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SpectatingMessage) GetArenaId() uint64 {
+	if x != nil {
+		return x.ArenaId
+	}
+	return 0
+}
+
+func (x *SpectatingMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type FinishedSpectatingMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FinishedSpectatingMessage) Reset() {
+	*x = FinishedSpectatingMessage{}
+	mi := &file_packets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishedSpectatingMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishedSpectatingMessage) ProtoMessage() {}
+
+func (x *FinishedSpectatingMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishedSpectatingMessage.ProtoReflect.Descriptor instead.
+func (*FinishedSpectatingMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{26}
+}
+
+type DeathSummaryMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KillerId    uint64 `protobuf:"varint,1,opt,name=killer_id,json=killerId,proto3" json:"killer_id,omitempty"`
+	KillerName  string `protobuf:"bytes,2,opt,name=killer_name,json=killerName,proto3" json:"killer_name,omitempty"`
+	PeakMass    uint64 `protobuf:"varint,3,opt,name=peak_mass,json=peakMass,proto3" json:"peak_mass,omitempty"`
+	TimeAliveMs uint64 `protobuf:"varint,4,opt,name=time_alive_ms,json=timeAliveMs,proto3" json:"time_alive_ms,omitempty"`
+	SporesEaten uint64 `protobuf:"varint,5,opt,name=spores_eaten,json=sporesEaten,proto3" json:"spores_eaten,omitempty"`
+}
+
+func (x *DeathSummaryMessage) Reset() {
+	*x = DeathSummaryMessage{}
+	mi := &file_packets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeathSummaryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeathSummaryMessage) ProtoMessage() {}
+
+func (x *DeathSummaryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeathSummaryMessage.ProtoReflect.Descriptor instead.
+func (*DeathSummaryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeathSummaryMessage) GetKillerId() uint64 {
+	if x != nil {
+		return x.KillerId
+	}
+	return 0
+}
+
+func (x *DeathSummaryMessage) GetKillerName() string {
+	if x != nil {
+		return x.KillerName
+	}
+	return ""
+}
+
+func (x *DeathSummaryMessage) GetPeakMass() uint64 {
+	if x != nil {
+		return x.PeakMass
+	}
+	return 0
+}
+
+func (x *DeathSummaryMessage) GetTimeAliveMs() uint64 {
+	if x != nil {
+		return x.TimeAliveMs
+	}
+	return 0
+}
+
+func (x *DeathSummaryMessage) GetSporesEaten() uint64 {
+	if x != nil {
+		return x.SporesEaten
+	}
+	return 0
+}
+
+type RespawnRequestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RespawnRequestMessage) Reset() {
+	*x = RespawnRequestMessage{}
+	mi := &file_packets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespawnRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnRequestMessage) ProtoMessage() {}
+
+func (x *RespawnRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnRequestMessage.ProtoReflect.Descriptor instead.
+func (*RespawnRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{28}
+}
+
+type SplitMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SplitMessage) Reset() {
+	*x = SplitMessage{}
+	mi := &file_packets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitMessage) ProtoMessage() {}
+
+func (x *SplitMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitMessage.ProtoReflect.Descriptor instead.
+func (*SplitMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{29}
+}
+
+type CellRemovedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId uint64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	CellId   uint64 `protobuf:"varint,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+}
+
+func (x *CellRemovedMessage) Reset() {
+	*x = CellRemovedMessage{}
+	mi := &file_packets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CellRemovedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellRemovedMessage) ProtoMessage() {}
+
+func (x *CellRemovedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellRemovedMessage.ProtoReflect.Descriptor instead.
+func (*CellRemovedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CellRemovedMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *CellRemovedMessage) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+type EjectMassMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EjectMassMessage) Reset() {
+	*x = EjectMassMessage{}
+	mi := &file_packets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EjectMassMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EjectMassMessage) ProtoMessage() {}
+
+func (x *EjectMassMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EjectMassMessage.ProtoReflect.Descriptor instead.
+func (*EjectMassMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{31}
+}
+
+type HazardMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X      float64 `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y      float64 `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius float64 `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+}
+
+func (x *HazardMessage) Reset() {
+	*x = HazardMessage{}
+	mi := &file_packets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HazardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HazardMessage) ProtoMessage() {}
+
+func (x *HazardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HazardMessage.ProtoReflect.Descriptor instead.
+func (*HazardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HazardMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HazardMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *HazardMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *HazardMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+type HazardConsumedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HazardId uint64 `protobuf:"varint,1,opt,name=hazard_id,json=hazardId,proto3" json:"hazard_id,omitempty"`
+	CellId   uint64 `protobuf:"varint,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+}
+
+func (x *HazardConsumedMessage) Reset() {
+	*x = HazardConsumedMessage{}
+	mi := &file_packets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HazardConsumedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HazardConsumedMessage) ProtoMessage() {}
+
+func (x *HazardConsumedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HazardConsumedMessage.ProtoReflect.Descriptor instead.
+func (*HazardConsumedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HazardConsumedMessage) GetHazardId() uint64 {
+	if x != nil {
+		return x.HazardId
+	}
+	return 0
+}
+
+func (x *HazardConsumedMessage) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+type BoostMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *BoostMessage) Reset() {
+	*x = BoostMessage{}
+	mi := &file_packets_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoostMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoostMessage) ProtoMessage() {}
+
+func (x *BoostMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoostMessage.ProtoReflect.Descriptor instead.
+func (*BoostMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BoostMessage) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type PowerUpMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X      float64 `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y      float64 `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius float64 `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Kind   string  `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *PowerUpMessage) Reset() {
+	*x = PowerUpMessage{}
+	mi := &file_packets_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpMessage) ProtoMessage() {}
+
+func (x *PowerUpMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PowerUpMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type PowerUpCollectedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PowerUpId  uint64 `protobuf:"varint,1,opt,name=power_up_id,json=powerUpId,proto3" json:"power_up_id,omitempty"`
+	Kind       string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	DurationMs uint64 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *PowerUpCollectedMessage) Reset() {
+	*x = PowerUpCollectedMessage{}
+	mi := &file_packets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpCollectedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpCollectedMessage) ProtoMessage() {}
+
+func (x *PowerUpCollectedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpCollectedMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpCollectedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PowerUpCollectedMessage) GetPowerUpId() uint64 {
+	if x != nil {
+		return x.PowerUpId
+	}
+	return 0
+}
+
+func (x *PowerUpCollectedMessage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PowerUpCollectedMessage) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type GameModeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RoundMsLeft uint64 `protobuf:"varint,2,opt,name=round_ms_left,json=roundMsLeft,proto3" json:"round_ms_left,omitempty"`
+	Lobby       bool   `protobuf:"varint,3,opt,name=lobby,proto3" json:"lobby,omitempty"`
+}
+
+func (x *GameModeMessage) Reset() {
+	*x = GameModeMessage{}
+	mi := &file_packets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameModeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameModeMessage) ProtoMessage() {}
+
+func (x *GameModeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameModeMessage.ProtoReflect.Descriptor instead.
+func (*GameModeMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GameModeMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameModeMessage) GetRoundMsLeft() uint64 {
+	if x != nil {
+		return x.RoundMsLeft
+	}
+	return 0
+}
+
+func (x *GameModeMessage) GetLobby() bool {
+	if x != nil {
+		return x.Lobby
+	}
+	return false
+}
+
+type SafeZoneMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X      float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y      float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Radius float64 `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+}
+
+func (x *SafeZoneMessage) Reset() {
+	*x = SafeZoneMessage{}
+	mi := &file_packets_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SafeZoneMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafeZoneMessage) ProtoMessage() {}
+
+func (x *SafeZoneMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafeZoneMessage.ProtoReflect.Descriptor instead.
+func (*SafeZoneMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SafeZoneMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SafeZoneMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SafeZoneMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+type StandingMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Score uint64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *StandingMessage) Reset() {
+	*x = StandingMessage{}
+	mi := &file_packets_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandingMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingMessage) ProtoMessage() {}
+
+func (x *StandingMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingMessage.ProtoReflect.Descriptor instead.
+func (*StandingMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StandingMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StandingMessage) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type RoundOverMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winner    string             `protobuf:"bytes,1,opt,name=winner,proto3" json:"winner,omitempty"`
+	Standings []*StandingMessage `protobuf:"bytes,2,rep,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *RoundOverMessage) Reset() {
+	*x = RoundOverMessage{}
+	mi := &file_packets_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundOverMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundOverMessage) ProtoMessage() {}
+
+func (x *RoundOverMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundOverMessage.ProtoReflect.Descriptor instead.
+func (*RoundOverMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RoundOverMessage) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *RoundOverMessage) GetStandings() []*StandingMessage {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type MassShareMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId uint64  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Mass     float64 `protobuf:"fixed64,2,opt,name=mass,proto3" json:"mass,omitempty"`
+}
+
+func (x *MassShareMessage) Reset() {
+	*x = MassShareMessage{}
+	mi := &file_packets_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MassShareMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MassShareMessage) ProtoMessage() {}
+
+func (x *MassShareMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MassShareMessage.ProtoReflect.Descriptor instead.
+func (*MassShareMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MassShareMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *MassShareMessage) GetMass() float64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+type TeamScoreMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team    uint32 `protobuf:"varint,1,opt,name=team,proto3" json:"team,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color   int32  `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	Players uint64 `protobuf:"varint,4,opt,name=players,proto3" json:"players,omitempty"`
+	Score   uint64 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *TeamScoreMessage) Reset() {
+	*x = TeamScoreMessage{}
+	mi := &file_packets_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoreMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoreMessage) ProtoMessage() {}
+
+func (x *TeamScoreMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoreMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoreMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TeamScoreMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamScoreMessage) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetPlayers() uint64 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type TeamScoresMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teams []*TeamScoreMessage `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+}
+
+func (x *TeamScoresMessage) Reset() {
+	*x = TeamScoresMessage{}
+	mi := &file_packets_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoresMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoresMessage) ProtoMessage() {}
+
+func (x *TeamScoresMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoresMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoresMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TeamScoresMessage) GetTeams() []*TeamScoreMessage {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type LeaderboardEntryMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId uint64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Mass     uint64 `protobuf:"varint,3,opt,name=mass,proto3" json:"mass,omitempty"`
+}
+
+func (x *LeaderboardEntryMessage) Reset() {
+	*x = LeaderboardEntryMessage{}
+	mi := &file_packets_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntryMessage) ProtoMessage() {}
+
+func (x *LeaderboardEntryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntryMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LeaderboardEntryMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *LeaderboardEntryMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeaderboardEntryMessage) GetMass() uint64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+type LeaderboardMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LeaderboardEntryMessage `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	OwnRank uint64                     `protobuf:"varint,2,opt,name=own_rank,json=ownRank,proto3" json:"own_rank,omitempty"`
+	OwnMass uint64                     `protobuf:"varint,3,opt,name=own_mass,json=ownMass,proto3" json:"own_mass,omitempty"`
+	Players uint64                     `protobuf:"varint,4,opt,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *LeaderboardMessage) Reset() {
+	*x = LeaderboardMessage{}
+	mi := &file_packets_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardMessage) ProtoMessage() {}
+
+func (x *LeaderboardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LeaderboardMessage) GetEntries() []*LeaderboardEntryMessage {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LeaderboardMessage) GetOwnRank() uint64 {
+	if x != nil {
+		return x.OwnRank
+	}
+	return 0
+}
+
+func (x *LeaderboardMessage) GetOwnMass() uint64 {
+	if x != nil {
+		return x.OwnMass
+	}
+	return 0
+}
+
+func (x *LeaderboardMessage) GetPlayers() uint64 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+type KillFeedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KillerId   uint64  `protobuf:"varint,1,opt,name=killer_id,json=killerId,proto3" json:"killer_id,omitempty"`
+	KillerName string  `protobuf:"bytes,2,opt,name=killer_name,json=killerName,proto3" json:"killer_name,omitempty"`
+	VictimId   uint64  `protobuf:"varint,3,opt,name=victim_id,json=victimId,proto3" json:"victim_id,omitempty"`
+	VictimName string  `protobuf:"bytes,4,opt,name=victim_name,json=victimName,proto3" json:"victim_name,omitempty"`
+	Mass       float64 `protobuf:"fixed64,5,opt,name=mass,proto3" json:"mass,omitempty"`
+}
+
+func (x *KillFeedMessage) Reset() {
+	*x = KillFeedMessage{}
+	mi := &file_packets_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillFeedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillFeedMessage) ProtoMessage() {}
+
+func (x *KillFeedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillFeedMessage.ProtoReflect.Descriptor instead.
+func (*KillFeedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *KillFeedMessage) GetKillerId() uint64 {
+	if x != nil {
+		return x.KillerId
+	}
+	return 0
+}
+
+func (x *KillFeedMessage) GetKillerName() string {
+	if x != nil {
+		return x.KillerName
+	}
+	return ""
+}
+
+func (x *KillFeedMessage) GetVictimId() uint64 {
+	if x != nil {
+		return x.VictimId
+	}
+	return 0
+}
+
+func (x *KillFeedMessage) GetVictimName() string {
+	if x != nil {
+		return x.VictimName
+	}
+	return ""
+}
+
+func (x *KillFeedMessage) GetMass() float64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+type AnnouncementMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	PlayerId uint64 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Text     string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *AnnouncementMessage) Reset() {
+	*x = AnnouncementMessage{}
+	mi := &file_packets_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementMessage) ProtoMessage() {}
+
+func (x *AnnouncementMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementMessage.ProtoReflect.Descriptor instead.
+func (*AnnouncementMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AnnouncementMessage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AnnouncementMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *AnnouncementMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ComboMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Combo      uint32  `protobuf:"varint,1,opt,name=combo,proto3" json:"combo,omitempty"`
+	Multiplier float64 `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *ComboMessage) Reset() {
+	*x = ComboMessage{}
+	mi := &file_packets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComboMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComboMessage) ProtoMessage() {}
+
+func (x *ComboMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComboMessage.ProtoReflect.Descriptor instead.
+func (*ComboMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ComboMessage) GetCombo() uint32 {
+	if x != nil {
+		return x.Combo
+	}
+	return 0
+}
+
+func (x *ComboMessage) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type SporesExpiredMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SporeIds []uint64 `protobuf:"varint,1,rep,packed,name=spore_ids,json=sporeIds,proto3" json:"spore_ids,omitempty"`
+}
+
+func (x *SporesExpiredMessage) Reset() {
+	*x = SporesExpiredMessage{}
+	mi := &file_packets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SporesExpiredMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SporesExpiredMessage) ProtoMessage() {}
+
+func (x *SporesExpiredMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SporesExpiredMessage.ProtoReflect.Descriptor instead.
+func (*SporesExpiredMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SporesExpiredMessage) GetSporeIds() []uint64 {
+	if x != nil {
+		return x.SporeIds
+	}
+	return nil
+}
+
+type ZoneMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ZoneId     uint32    `protobuf:"varint,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	State      ZoneState `protobuf:"varint,2,opt,name=state,proto3,enum=packets.ZoneState" json:"state,omitempty"`
+	HolderId   uint64    `protobuf:"varint,3,opt,name=holder_id,json=holderId,proto3" json:"holder_id,omitempty"`
+	HolderName string    `protobuf:"bytes,4,opt,name=holder_name,json=holderName,proto3" json:"holder_name,omitempty"`
+}
+
+func (x *ZoneMessage) Reset() {
+	*x = ZoneMessage{}
+	mi := &file_packets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneMessage) ProtoMessage() {}
+
+func (x *ZoneMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneMessage.ProtoReflect.Descriptor instead.
+func (*ZoneMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ZoneMessage) GetZoneId() uint32 {
+	if x != nil {
+		return x.ZoneId
+	}
+	return 0
+}
+
+func (x *ZoneMessage) GetState() ZoneState {
+	if x != nil {
+		return x.State
+	}
+	return ZoneState_ZONE_NEUTRAL
+}
+
+func (x *ZoneMessage) GetHolderId() uint64 {
+	if x != nil {
+		return x.HolderId
+	}
+	return 0
+}
+
+func (x *ZoneMessage) GetHolderName() string {
+	if x != nil {
+		return x.HolderName
+	}
+	return ""
+}
+
+type RegionMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X      float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y      float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width  float64 `protobuf:"fixed64,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height float64 `protobuf:"fixed64,4,opt,name=height,proto3" json:"height,omitempty"`
+	Weight float64 `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *RegionMessage) Reset() {
+	*x = RegionMessage{}
+	mi := &file_packets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionMessage) ProtoMessage() {}
+
+func (x *RegionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionMessage.ProtoReflect.Descriptor instead.
+func (*RegionMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RegionMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *RegionMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *RegionMessage) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *RegionMessage) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RegionMessage) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type WorldMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shape        WorldShape       `protobuf:"varint,1,opt,name=shape,proto3,enum=packets.WorldShape" json:"shape,omitempty"`
+	Size         float64          `protobuf:"fixed64,2,opt,name=size,proto3" json:"size,omitempty"`
+	MapName      string           `protobuf:"bytes,3,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	Obstacles    []*RegionMessage `protobuf:"bytes,4,rep,name=obstacles,proto3" json:"obstacles,omitempty"`
+	SpawnZones   []*RegionMessage `protobuf:"bytes,5,rep,name=spawn_zones,json=spawnZones,proto3" json:"spawn_zones,omitempty"`
+	SporeRegions []*RegionMessage `protobuf:"bytes,6,rep,name=spore_regions,json=sporeRegions,proto3" json:"spore_regions,omitempty"`
+	ControlZones []*RegionMessage `protobuf:"bytes,7,rep,name=control_zones,json=controlZones,proto3" json:"control_zones,omitempty"`
+}
+
+func (x *WorldMessage) Reset() {
+	*x = WorldMessage{}
+	mi := &file_packets_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldMessage) ProtoMessage() {}
+
+func (x *WorldMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldMessage.ProtoReflect.Descriptor instead.
+func (*WorldMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WorldMessage) GetShape() WorldShape {
+	if x != nil {
+		return x.Shape
+	}
+	return WorldShape_WORLD_RECT
+}
+
+func (x *WorldMessage) GetSize() float64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *WorldMessage) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *WorldMessage) GetObstacles() []*RegionMessage {
+	if x != nil {
+		return x.Obstacles
+	}
+	return nil
+}
+
+func (x *WorldMessage) GetSpawnZones() []*RegionMessage {
+	if x != nil {
+		return x.SpawnZones
+	}
+	return nil
+}
+
+func (x *WorldMessage) GetSporeRegions() []*RegionMessage {
+	if x != nil {
+		return x.SporeRegions
+	}
+	return nil
+}
+
+func (x *WorldMessage) GetControlZones() []*RegionMessage {
+	if x != nil {
+		return x.ControlZones
+	}
+	return nil
+}
+
+type Packet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderId uint64 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Types that are assignable to Msg:
+	//
+	//	*Packet_Chat
+	//	*Packet_Id
+	//	*Packet_LoginRequest
+	//	*Packet_RegisterRequest
+	//	*Packet_OkResponse
+	//	*Packet_DenyResponse
+	//	*Packet_Player
+	//	*Packet_PlayerDirection
+	//	*Packet_Spore
+	//	*Packet_SporeConsumed
+	//	*Packet_SporesBatch
+	//	*Packet_PlayerConsumed
+	//	*Packet_HiscoreBoardRequest
+	//	*Packet_Hiscore
+	//	*Packet_HiscoreBoard
+	//	*Packet_FinishedBrowsingHiscores
+	//	*Packet_SearchHiscore
+	//	*Packet_Disconnect
+	//	*Packet_ArenaListRequest
+	//	*Packet_ArenaList
+	//	*Packet_CreateArenaRequest
+	//	*Packet_ArenaCreated
+	//	*Packet_KickPlayer
+	//	*Packet_SpectateRequest
+	//	*Packet_Spectating
+	//	*Packet_FinishedSpectating
+	//	*Packet_DeathSummary
+	//	*Packet_RespawnRequest
+	//	*Packet_Split
+	//	*Packet_CellRemoved
+	//	*Packet_EjectMass
+	//	*Packet_Hazard
+	//	*Packet_HazardConsumed
+	//	*Packet_Boost
+	//	*Packet_World
+	//	*Packet_PowerUp
+	//	*Packet_PowerUpCollected
+	//	*Packet_GameMode
+	//	*Packet_SafeZone
+	//	*Packet_RoundOver
+	//	*Packet_MassShare
+	//	*Packet_TeamScores
+	//	*Packet_Zone
+	//	*Packet_Leaderboard
+	//	*Packet_KillFeed
+	//	*Packet_Announcement
+	//	*Packet_Combo
+	//	*Packet_SporesExpired
+	Msg isPacket_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	mi := &file_packets_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Packet) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (m *Packet) GetMsg() isPacket_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *Packet) GetChat() *ChatMessage {
+	if x, ok := x.GetMsg().(*Packet_Chat); ok {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *Packet) GetId() *IdMessage {
+	if x, ok := x.GetMsg().(*Packet_Id); ok {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+	if x, ok := x.GetMsg().(*Packet_LoginRequest); ok {
+		return x.LoginRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+	if x, ok := x.GetMsg().(*Packet_RegisterRequest); ok {
+		return x.RegisterRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetOkResponse() *OkResponseMessage {
+	if x, ok := x.GetMsg().(*Packet_OkResponse); ok {
+		return x.OkResponse
+	}
+	return nil
+}
+
+func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+	if x, ok := x.GetMsg().(*Packet_DenyResponse); ok {
+		return x.DenyResponse
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayer() *PlayerMessage {
+	if x, ok := x.GetMsg().(*Packet_Player); ok {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
 	if x, ok := x.GetMsg().(*Packet_PlayerDirection); ok {
 		return x.PlayerDirection
 	}
@@ -1122,6 +3489,216 @@ func (x *Packet) GetDisconnect() *DisconnectMessage {
 	return nil
 }
 
+func (x *Packet) GetArenaListRequest() *ArenaListRequestMessage {
+	if x, ok := x.GetMsg().(*Packet_ArenaListRequest); ok {
+		return x.ArenaListRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetArenaList() *ArenaListMessage {
+	if x, ok := x.GetMsg().(*Packet_ArenaList); ok {
+		return x.ArenaList
+	}
+	return nil
+}
+
+func (x *Packet) GetCreateArenaRequest() *CreateArenaRequestMessage {
+	if x, ok := x.GetMsg().(*Packet_CreateArenaRequest); ok {
+		return x.CreateArenaRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetArenaCreated() *ArenaCreatedMessage {
+	if x, ok := x.GetMsg().(*Packet_ArenaCreated); ok {
+		return x.ArenaCreated
+	}
+	return nil
+}
+
+func (x *Packet) GetKickPlayer() *KickPlayerMessage {
+	if x, ok := x.GetMsg().(*Packet_KickPlayer); ok {
+		return x.KickPlayer
+	}
+	return nil
+}
+
+func (x *Packet) GetSpectateRequest() *SpectateRequestMessage {
+	if x, ok := x.GetMsg().(*Packet_SpectateRequest); ok {
+		return x.SpectateRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetSpectating() *SpectatingMessage {
+	if x, ok := x.GetMsg().(*Packet_Spectating); ok {
+		return x.Spectating
+	}
+	return nil
+}
+
+func (x *Packet) GetFinishedSpectating() *FinishedSpectatingMessage {
+	if x, ok := x.GetMsg().(*Packet_FinishedSpectating); ok {
+		return x.FinishedSpectating
+	}
+	return nil
+}
+
+func (x *Packet) GetDeathSummary() *DeathSummaryMessage {
+	if x, ok := x.GetMsg().(*Packet_DeathSummary); ok {
+		return x.DeathSummary
+	}
+	return nil
+}
+
+func (x *Packet) GetRespawnRequest() *RespawnRequestMessage {
+	if x, ok := x.GetMsg().(*Packet_RespawnRequest); ok {
+		return x.RespawnRequest
+	}
+	return nil
+}
+
+func (x *Packet) GetSplit() *SplitMessage {
+	if x, ok := x.GetMsg().(*Packet_Split); ok {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *Packet) GetCellRemoved() *CellRemovedMessage {
+	if x, ok := x.GetMsg().(*Packet_CellRemoved); ok {
+		return x.CellRemoved
+	}
+	return nil
+}
+
+func (x *Packet) GetEjectMass() *EjectMassMessage {
+	if x, ok := x.GetMsg().(*Packet_EjectMass); ok {
+		return x.EjectMass
+	}
+	return nil
+}
+
+func (x *Packet) GetHazard() *HazardMessage {
+	if x, ok := x.GetMsg().(*Packet_Hazard); ok {
+		return x.Hazard
+	}
+	return nil
+}
+
+func (x *Packet) GetHazardConsumed() *HazardConsumedMessage {
+	if x, ok := x.GetMsg().(*Packet_HazardConsumed); ok {
+		return x.HazardConsumed
+	}
+	return nil
+}
+
+func (x *Packet) GetBoost() *BoostMessage {
+	if x, ok := x.GetMsg().(*Packet_Boost); ok {
+		return x.Boost
+	}
+	return nil
+}
+
+func (x *Packet) GetWorld() *WorldMessage {
+	if x, ok := x.GetMsg().(*Packet_World); ok {
+		return x.World
+	}
+	return nil
+}
+
+func (x *Packet) GetPowerUp() *PowerUpMessage {
+	if x, ok := x.GetMsg().(*Packet_PowerUp); ok {
+		return x.PowerUp
+	}
+	return nil
+}
+
+func (x *Packet) GetPowerUpCollected() *PowerUpCollectedMessage {
+	if x, ok := x.GetMsg().(*Packet_PowerUpCollected); ok {
+		return x.PowerUpCollected
+	}
+	return nil
+}
+
+func (x *Packet) GetGameMode() *GameModeMessage {
+	if x, ok := x.GetMsg().(*Packet_GameMode); ok {
+		return x.GameMode
+	}
+	return nil
+}
+
+func (x *Packet) GetSafeZone() *SafeZoneMessage {
+	if x, ok := x.GetMsg().(*Packet_SafeZone); ok {
+		return x.SafeZone
+	}
+	return nil
+}
+
+func (x *Packet) GetRoundOver() *RoundOverMessage {
+	if x, ok := x.GetMsg().(*Packet_RoundOver); ok {
+		return x.RoundOver
+	}
+	return nil
+}
+
+func (x *Packet) GetMassShare() *MassShareMessage {
+	if x, ok := x.GetMsg().(*Packet_MassShare); ok {
+		return x.MassShare
+	}
+	return nil
+}
+
+func (x *Packet) GetTeamScores() *TeamScoresMessage {
+	if x, ok := x.GetMsg().(*Packet_TeamScores); ok {
+		return x.TeamScores
+	}
+	return nil
+}
+
+func (x *Packet) GetZone() *ZoneMessage {
+	if x, ok := x.GetMsg().(*Packet_Zone); ok {
+		return x.Zone
+	}
+	return nil
+}
+
+func (x *Packet) GetLeaderboard() *LeaderboardMessage {
+	if x, ok := x.GetMsg().(*Packet_Leaderboard); ok {
+		return x.Leaderboard
+	}
+	return nil
+}
+
+func (x *Packet) GetKillFeed() *KillFeedMessage {
+	if x, ok := x.GetMsg().(*Packet_KillFeed); ok {
+		return x.KillFeed
+	}
+	return nil
+}
+
+func (x *Packet) GetAnnouncement() *AnnouncementMessage {
+	if x, ok := x.GetMsg().(*Packet_Announcement); ok {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *Packet) GetCombo() *ComboMessage {
+	if x, ok := x.GetMsg().(*Packet_Combo); ok {
+		return x.Combo
+	}
+	return nil
+}
+
+func (x *Packet) GetSporesExpired() *SporesExpiredMessage {
+	if x, ok := x.GetMsg().(*Packet_SporesExpired); ok {
+		return x.SporesExpired
+	}
+	return nil
+}
+
 type isPacket_Msg interface {
 	isPacket_Msg()
 }
@@ -1198,6 +3775,126 @@ type Packet_Disconnect struct {
 	Disconnect *DisconnectMessage `protobuf:"bytes,19,opt,name=disconnect,proto3,oneof"`
 }
 
+type Packet_ArenaListRequest struct {
+	ArenaListRequest *ArenaListRequestMessage `protobuf:"bytes,20,opt,name=arena_list_request,json=arenaListRequest,proto3,oneof"`
+}
+
+type Packet_ArenaList struct {
+	ArenaList *ArenaListMessage `protobuf:"bytes,21,opt,name=arena_list,json=arenaList,proto3,oneof"`
+}
+
+type Packet_CreateArenaRequest struct {
+	CreateArenaRequest *CreateArenaRequestMessage `protobuf:"bytes,22,opt,name=create_arena_request,json=createArenaRequest,proto3,oneof"`
+}
+
+type Packet_ArenaCreated struct {
+	ArenaCreated *ArenaCreatedMessage `protobuf:"bytes,23,opt,name=arena_created,json=arenaCreated,proto3,oneof"`
+}
+
+type Packet_KickPlayer struct {
+	KickPlayer *KickPlayerMessage `protobuf:"bytes,24,opt,name=kick_player,json=kickPlayer,proto3,oneof"`
+}
+
+type Packet_SpectateRequest struct {
+	SpectateRequest *SpectateRequestMessage `protobuf:"bytes,25,opt,name=spectate_request,json=spectateRequest,proto3,oneof"`
+}
+
+type Packet_Spectating struct {
+	Spectating *SpectatingMessage `protobuf:"bytes,26,opt,name=spectating,proto3,oneof"`
+}
+
+type Packet_FinishedSpectating struct {
+	FinishedSpectating *FinishedSpectatingMessage `protobuf:"bytes,27,opt,name=finished_spectating,json=finishedSpectating,proto3,oneof"`
+}
+
+type Packet_DeathSummary struct {
+	DeathSummary *DeathSummaryMessage `protobuf:"bytes,28,opt,name=death_summary,json=deathSummary,proto3,oneof"`
+}
+
+type Packet_RespawnRequest struct {
+	RespawnRequest *RespawnRequestMessage `protobuf:"bytes,29,opt,name=respawn_request,json=respawnRequest,proto3,oneof"`
+}
+
+type Packet_Split struct {
+	Split *SplitMessage `protobuf:"bytes,30,opt,name=split,proto3,oneof"`
+}
+
+type Packet_CellRemoved struct {
+	CellRemoved *CellRemovedMessage `protobuf:"bytes,31,opt,name=cell_removed,json=cellRemoved,proto3,oneof"`
+}
+
+type Packet_EjectMass struct {
+	EjectMass *EjectMassMessage `protobuf:"bytes,32,opt,name=eject_mass,json=ejectMass,proto3,oneof"`
+}
+
+type Packet_Hazard struct {
+	Hazard *HazardMessage `protobuf:"bytes,33,opt,name=hazard,proto3,oneof"`
+}
+
+type Packet_HazardConsumed struct {
+	HazardConsumed *HazardConsumedMessage `protobuf:"bytes,34,opt,name=hazard_consumed,json=hazardConsumed,proto3,oneof"`
+}
+
+type Packet_Boost struct {
+	Boost *BoostMessage `protobuf:"bytes,35,opt,name=boost,proto3,oneof"`
+}
+
+type Packet_World struct {
+	World *WorldMessage `protobuf:"bytes,36,opt,name=world,proto3,oneof"`
+}
+
+type Packet_PowerUp struct {
+	PowerUp *PowerUpMessage `protobuf:"bytes,37,opt,name=power_up,json=powerUp,proto3,oneof"`
+}
+
+type Packet_PowerUpCollected struct {
+	PowerUpCollected *PowerUpCollectedMessage `protobuf:"bytes,38,opt,name=power_up_collected,json=powerUpCollected,proto3,oneof"`
+}
+
+type Packet_GameMode struct {
+	GameMode *GameModeMessage `protobuf:"bytes,39,opt,name=game_mode,json=gameMode,proto3,oneof"`
+}
+
+type Packet_SafeZone struct {
+	SafeZone *SafeZoneMessage `protobuf:"bytes,40,opt,name=safe_zone,json=safeZone,proto3,oneof"`
+}
+
+type Packet_RoundOver struct {
+	RoundOver *RoundOverMessage `protobuf:"bytes,41,opt,name=round_over,json=roundOver,proto3,oneof"`
+}
+
+type Packet_MassShare struct {
+	MassShare *MassShareMessage `protobuf:"bytes,42,opt,name=mass_share,json=massShare,proto3,oneof"`
+}
+
+type Packet_TeamScores struct {
+	TeamScores *TeamScoresMessage `protobuf:"bytes,43,opt,name=team_scores,json=teamScores,proto3,oneof"`
+}
+
+type Packet_Zone struct {
+	Zone *ZoneMessage `protobuf:"bytes,44,opt,name=zone,proto3,oneof"`
+}
+
+type Packet_Leaderboard struct {
+	Leaderboard *LeaderboardMessage `protobuf:"bytes,45,opt,name=leaderboard,proto3,oneof"`
+}
+
+type Packet_KillFeed struct {
+	KillFeed *KillFeedMessage `protobuf:"bytes,46,opt,name=kill_feed,json=killFeed,proto3,oneof"`
+}
+
+type Packet_Announcement struct {
+	Announcement *AnnouncementMessage `protobuf:"bytes,47,opt,name=announcement,proto3,oneof"`
+}
+
+type Packet_Combo struct {
+	Combo *ComboMessage `protobuf:"bytes,48,opt,name=combo,proto3,oneof"`
+}
+
+type Packet_SporesExpired struct {
+	SporesExpired *SporesExpiredMessage `protobuf:"bytes,49,opt,name=spores_expired,json=sporesExpired,proto3,oneof"`
+}
+
 func (*Packet_Chat) isPacket_Msg() {}
 
 func (*Packet_Id) isPacket_Msg() {}
@@ -1234,160 +3931,598 @@ func (*Packet_SearchHiscore) isPacket_Msg() {}
 
 func (*Packet_Disconnect) isPacket_Msg() {}
 
+func (*Packet_ArenaListRequest) isPacket_Msg() {}
+
+func (*Packet_ArenaList) isPacket_Msg() {}
+
+func (*Packet_CreateArenaRequest) isPacket_Msg() {}
+
+func (*Packet_ArenaCreated) isPacket_Msg() {}
+
+func (*Packet_KickPlayer) isPacket_Msg() {}
+
+func (*Packet_SpectateRequest) isPacket_Msg() {}
+
+func (*Packet_Spectating) isPacket_Msg() {}
+
+func (*Packet_FinishedSpectating) isPacket_Msg() {}
+
+func (*Packet_DeathSummary) isPacket_Msg() {}
+
+func (*Packet_RespawnRequest) isPacket_Msg() {}
+
+func (*Packet_Split) isPacket_Msg() {}
+
+func (*Packet_CellRemoved) isPacket_Msg() {}
+
+func (*Packet_EjectMass) isPacket_Msg() {}
+
+func (*Packet_Hazard) isPacket_Msg() {}
+
+func (*Packet_HazardConsumed) isPacket_Msg() {}
+
+func (*Packet_Boost) isPacket_Msg() {}
+
+func (*Packet_World) isPacket_Msg() {}
+
+func (*Packet_PowerUp) isPacket_Msg() {}
+
+func (*Packet_PowerUpCollected) isPacket_Msg() {}
+
+func (*Packet_GameMode) isPacket_Msg() {}
+
+func (*Packet_SafeZone) isPacket_Msg() {}
+
+func (*Packet_RoundOver) isPacket_Msg() {}
+
+func (*Packet_MassShare) isPacket_Msg() {}
+
+func (*Packet_TeamScores) isPacket_Msg() {}
+
+func (*Packet_Zone) isPacket_Msg() {}
+
+func (*Packet_Leaderboard) isPacket_Msg() {}
+
+func (*Packet_KillFeed) isPacket_Msg() {}
+
+func (*Packet_Announcement) isPacket_Msg() {}
+
+func (*Packet_Combo) isPacket_Msg() {}
+
+func (*Packet_SporesExpired) isPacket_Msg() {}
+
 var File_packets_proto protoreflect.FileDescriptor
 
 var file_packets_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x1f, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74,
 	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x1b, 0x0a, 0x09, 0x49, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61,
+	0x6d, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65,
+	0x61, 0x6d, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x1b, 0x0a, 0x09, 0x49, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x6e, 0x61,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x72, 0x65, 0x6e, 0x61,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x66, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
 	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
 	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x66, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x13, 0x0a,
-	0x11, 0x4f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x4f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x2d, 0x0a, 0x13, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x8d, 0x03, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x6f, 0x75, 0x6e, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62,
+	0x6f, 0x75, 0x6e, 0x74, 0x79, 0x12, 0x13, 0x0a, 0x05, 0x76, 0x65, 0x6c, 0x5f, 0x78, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x65, 0x6c, 0x58, 0x12, 0x13, 0x0a, 0x05, 0x76, 0x65,
+	0x6c, 0x5f, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x65, 0x6c, 0x59, 0x22,
+	0x62, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x6f, 0x74,
+	0x74, 0x6c, 0x65, 0x22, 0x66, 0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a,
-	0x0c, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a,
-	0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64,
-	0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75,
-	0x73, 0x22, 0x31, 0x0a, 0x14, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x6f,
-	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x6f,
-	0x72, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x12, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x70,
-	0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x1c, 0x0a, 0x1a, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4e, 0x0a,
-	0x0e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72,
-	0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x4a, 0x0a,
-	0x13, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x08, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x14,
+	0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
+	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x4a, 0x0a, 0x14, 0x53,
+	0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x12, 0x53, 0x70, 0x6f, 0x72, 0x65,
+	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x15,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x65,
+	0x61, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x22,
+	0x38, 0x0a, 0x1a, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x4e, 0x0a, 0x0e, 0x48, 0x69, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x66, 0x0a, 0x13, 0x48, 0x69, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x33, 0x0a, 0x08, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x68, 0x69, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x22, 0x21, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x2b, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x19, 0x0a,
+	0x17, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x0c, 0x41, 0x72, 0x65,
+	0x6e, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x41, 0x0a, 0x10, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x72, 0x65, 0x6e, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06,
+	0x61, 0x72, 0x65, 0x6e, 0x61, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x6f, 0x72,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6c,
+	0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x68, 0x61,
+	0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x51, 0x0a, 0x13, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x6e,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x72, 0x65, 0x6e,
+	0x61, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x30, 0x0a, 0x11, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x16, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x11, 0x53, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x61, 0x74, 0x68, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6b,
+	0x69, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b,
+	0x69, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x61,
+	0x6b, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x65,
+	0x61, 0x6b, 0x4d, 0x61, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61,
+	0x6c, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70,
+	0x6f, 0x72, 0x65, 0x73, 0x5f, 0x65, 0x61, 0x74, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x61, 0x74, 0x65, 0x6e, 0x22, 0x17, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x12, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c,
+	0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x53, 0x0a, 0x0d, 0x48, 0x61, 0x7a, 0x61, 0x72, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x22, 0x4d, 0x0a, 0x15, 0x48,
+	0x61, 0x7a, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x0c, 0x42, 0x6f,
+	0x6f, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x22, 0x68, 0x0a, 0x0e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x6e, 0x0a, 0x17,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x55, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x5f, 0x0a, 0x0f,
+	0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6d, 0x73, 0x5f,
+	0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x4d, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x22, 0x45, 0x0a,
+	0x0f, 0x53, 0x61, 0x66, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x62, 0x0a, 0x10, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x43, 0x0a, 0x10, 0x4d, 0x61, 0x73, 0x73, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x54,
+	0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
+	0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x44, 0x0a,
+	0x11, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6d,
+	0x61, 0x73, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x52, 0x61, 0x6e,
+	0x6b, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x4d, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x4b, 0x69, 0x6c, 0x6c, 0x46,
+	0x65, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x69,
+	0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6b,
+	0x69, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x69,
+	0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x63, 0x74,
+	0x69, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x69, 0x63,
+	0x74, 0x69, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6d, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x63, 0x74,
+	0x69, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x22, 0x5a, 0x0a, 0x13, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x44, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x14,
+	0x53, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x49, 0x64,
+	0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x7a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x71, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
+	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd1, 0x02, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x34, 0x0a, 0x09, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x73,
+	0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x0a, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12,
+	0x3b, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c,
+	0x73, 0x70, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0xd5, 0x18, 0x0a, 0x06, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x24, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x49, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x6f, 0x6b, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65,
+	0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x10,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x70,
+	0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x70, 0x6f,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72,
+	0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x64, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x59,
+	0x0a, 0x15, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x13, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x69, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x43,
+	0x0a, 0x0d, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x68, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x62, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69,
+	0x6e, 0x67, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x18, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x46, 0x0a,
+	0x0e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
 	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xde, 0x09, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a,
-	0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x49, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x43, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x6f, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x4f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05,
-	0x73, 0x70, 0x6f, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d,
-	0x73, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x40, 0x0a,
-	0x0c, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70,
-	0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x48, 0x00, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x49, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x15, 0x68, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x12, 0x50, 0x0a, 0x12, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x10, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x56, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x6e,
+	0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x72, 0x65, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x65,
+	0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x72, 0x65,
+	0x6e, 0x61, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x72, 0x65, 0x6e, 0x61,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0c, 0x61, 0x72, 0x65, 0x6e, 0x61, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3d,
+	0x0a, 0x0b, 0x6b, 0x69, 0x63, 0x6b, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4b, 0x69,
+	0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4c, 0x0a,
+	0x10, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a, 0x13, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x43, 0x0a, 0x0d, 0x64, 0x65, 0x61, 0x74, 0x68, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x61, 0x74, 0x68, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x13, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x07, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x68, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12,
-	0x68, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x72, 0x6f, 0x77,
-	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e, 0x67, 0x48, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x18, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e,
-	0x67, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x42,
-	0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12,
+	0x40, 0x0a, 0x0c, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18,
+	0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x45, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x09, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x73, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x68, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x12,
+	0x49, 0x0a, 0x0f, 0x68, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x48, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x68, 0x61, 0x7a, 0x61,
+	0x72, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x6f,
+	0x6f, 0x73, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x77, 0x6f, 0x72,
+	0x6c, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x75, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x12, 0x50,
+	0x0a, 0x12, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x37, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x27, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x61, 0x66,
+	0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x61, 0x66, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x61, 0x66, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x12, 0x3a,
+	0x0a, 0x0a, 0x6d, 0x61, 0x73, 0x73, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x2a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x73,
+	0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x09, 0x6d, 0x61, 0x73, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x65,
+	0x61, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x66,
+	0x65, 0x65, 0x64, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6b, 0x69, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x12,
+	0x42, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x18, 0x30, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x62, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x6d,
+	0x62, 0x6f, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x70, 0x6f,
+	0x72, 0x65, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x2a, 0x3f, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12,
+	0x0e, 0x0a, 0x0a, 0x57, 0x4f, 0x52, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12,
+	0x10, 0x0a, 0x0c, 0x57, 0x4f, 0x52, 0x4c, 0x44, 0x5f, 0x43, 0x49, 0x52, 0x43, 0x4c, 0x45, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x4f, 0x52, 0x4c, 0x44, 0x5f, 0x54, 0x4f, 0x52, 0x55, 0x53,
+	0x10, 0x02, 0x2a, 0x40, 0x0a, 0x09, 0x5a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x10, 0x0a, 0x0c, 0x5a, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x45, 0x55, 0x54, 0x52, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x5a, 0x4f, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x53,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x5a, 0x4f, 0x4e, 0x45, 0x5f, 0x48, 0x45,
+	0x4c, 0x44, 0x10, 0x02, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1402,54 +4537,133 @@ func file_packets_proto_rawDescGZIP() []byte {
 	return file_packets_proto_rawDescData
 }
 
-var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_packets_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_packets_proto_goTypes = []any{
-	(*ChatMessage)(nil),                     // 0: packets.ChatMessage
-	(*IdMessage)(nil),                       // 1: packets.IdMessage
-	(*LoginRequestMessage)(nil),             // 2: packets.LoginRequestMessage
-	(*RegisterRequestMessage)(nil),          // 3: packets.RegisterRequestMessage
-	(*OkResponseMessage)(nil),               // 4: packets.OkResponseMessage
-	(*DenyResponseMessage)(nil),             // 5: packets.DenyResponseMessage
-	(*PlayerMessage)(nil),                   // 6: packets.PlayerMessage
-	(*PlayerDirectionMessage)(nil),          // 7: packets.PlayerDirectionMessage
-	(*SporeMessage)(nil),                    // 8: packets.SporeMessage
-	(*SporeConsumedMessage)(nil),            // 9: packets.SporeConsumedMessage
-	(*SporesBatchMessage)(nil),              // 10: packets.SporesBatchMessage
-	(*PlayerConsumedMessage)(nil),           // 11: packets.PlayerConsumedMessage
-	(*HiscoreBoardRequestMessage)(nil),      // 12: packets.HiscoreBoardRequestMessage
-	(*HiscoreMessage)(nil),                  // 13: packets.HiscoreMessage
-	(*HiscoreBoardMessage)(nil),             // 14: packets.HiscoreBoardMessage
-	(*FinishedBrowsingHiscoresMessage)(nil), // 15: packets.FinishedBrowsingHiscoresMessage
-	(*SearchHiscoreMessage)(nil),            // 16: packets.SearchHiscoreMessage
-	(*DisconnectMessage)(nil),               // 17: packets.DisconnectMessage
-	(*Packet)(nil),                          // 18: packets.Packet
+	(WorldShape)(0),                         // 0: packets.WorldShape
+	(ZoneState)(0),                          // 1: packets.ZoneState
+	(*ChatMessage)(nil),                     // 2: packets.ChatMessage
+	(*IdMessage)(nil),                       // 3: packets.IdMessage
+	(*LoginRequestMessage)(nil),             // 4: packets.LoginRequestMessage
+	(*RegisterRequestMessage)(nil),          // 5: packets.RegisterRequestMessage
+	(*OkResponseMessage)(nil),               // 6: packets.OkResponseMessage
+	(*DenyResponseMessage)(nil),             // 7: packets.DenyResponseMessage
+	(*PlayerMessage)(nil),                   // 8: packets.PlayerMessage
+	(*PlayerDirectionMessage)(nil),          // 9: packets.PlayerDirectionMessage
+	(*SporeMessage)(nil),                    // 10: packets.SporeMessage
+	(*SporeConsumedMessage)(nil),            // 11: packets.SporeConsumedMessage
+	(*SporesBatchMessage)(nil),              // 12: packets.SporesBatchMessage
+	(*PlayerConsumedMessage)(nil),           // 13: packets.PlayerConsumedMessage
+	(*HiscoreBoardRequestMessage)(nil),      // 14: packets.HiscoreBoardRequestMessage
+	(*HiscoreMessage)(nil),                  // 15: packets.HiscoreMessage
+	(*HiscoreBoardMessage)(nil),             // 16: packets.HiscoreBoardMessage
+	(*FinishedBrowsingHiscoresMessage)(nil), // 17: packets.FinishedBrowsingHiscoresMessage
+	(*SearchHiscoreMessage)(nil),            // 18: packets.SearchHiscoreMessage
+	(*DisconnectMessage)(nil),               // 19: packets.DisconnectMessage
+	(*ArenaListRequestMessage)(nil),         // 20: packets.ArenaListRequestMessage
+	(*ArenaMessage)(nil),                    // 21: packets.ArenaMessage
+	(*ArenaListMessage)(nil),                // 22: packets.ArenaListMessage
+	(*CreateArenaRequestMessage)(nil),       // 23: packets.CreateArenaRequestMessage
+	(*ArenaCreatedMessage)(nil),             // 24: packets.ArenaCreatedMessage
+	(*KickPlayerMessage)(nil),               // 25: packets.KickPlayerMessage
+	(*SpectateRequestMessage)(nil),          // 26: packets.SpectateRequestMessage
+	(*SpectatingMessage)(nil),               // 27: packets.SpectatingMessage
+	(*FinishedSpectatingMessage)(nil),       // 28: packets.FinishedSpectatingMessage
+	(*DeathSummaryMessage)(nil),             // 29: packets.DeathSummaryMessage
+	(*RespawnRequestMessage)(nil),           // 30: packets.RespawnRequestMessage
+	(*SplitMessage)(nil),                    // 31: packets.SplitMessage
+	(*CellRemovedMessage)(nil),              // 32: packets.CellRemovedMessage
+	(*EjectMassMessage)(nil),                // 33: packets.EjectMassMessage
+	(*HazardMessage)(nil),                   // 34: packets.HazardMessage
+	(*HazardConsumedMessage)(nil),           // 35: packets.HazardConsumedMessage
+	(*BoostMessage)(nil),                    // 36: packets.BoostMessage
+	(*PowerUpMessage)(nil),                  // 37: packets.PowerUpMessage
+	(*PowerUpCollectedMessage)(nil),         // 38: packets.PowerUpCollectedMessage
+	(*GameModeMessage)(nil),                 // 39: packets.GameModeMessage
+	(*SafeZoneMessage)(nil),                 // 40: packets.SafeZoneMessage
+	(*StandingMessage)(nil),                 // 41: packets.StandingMessage
+	(*RoundOverMessage)(nil),                // 42: packets.RoundOverMessage
+	(*MassShareMessage)(nil),                // 43: packets.MassShareMessage
+	(*TeamScoreMessage)(nil),                // 44: packets.TeamScoreMessage
+	(*TeamScoresMessage)(nil),               // 45: packets.TeamScoresMessage
+	(*LeaderboardEntryMessage)(nil),         // 46: packets.LeaderboardEntryMessage
+	(*LeaderboardMessage)(nil),              // 47: packets.LeaderboardMessage
+	(*KillFeedMessage)(nil),                 // 48: packets.KillFeedMessage
+	(*AnnouncementMessage)(nil),             // 49: packets.AnnouncementMessage
+	(*ComboMessage)(nil),                    // 50: packets.ComboMessage
+	(*SporesExpiredMessage)(nil),            // 51: packets.SporesExpiredMessage
+	(*ZoneMessage)(nil),                     // 52: packets.ZoneMessage
+	(*RegionMessage)(nil),                   // 53: packets.RegionMessage
+	(*WorldMessage)(nil),                    // 54: packets.WorldMessage
+	(*Packet)(nil),                          // 55: packets.Packet
 }
 var file_packets_proto_depIdxs = []int32{
-	8,  // 0: packets.SporesBatchMessage.spores:type_name -> packets.SporeMessage
-	13, // 1: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
-	0,  // 2: packets.Packet.chat:type_name -> packets.ChatMessage
-	1,  // 3: packets.Packet.id:type_name -> packets.IdMessage
-	2,  // 4: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
-	3,  // 5: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
-	4,  // 6: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
-	5,  // 7: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
-	6,  // 8: packets.Packet.player:type_name -> packets.PlayerMessage
-	7,  // 9: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
-	8,  // 10: packets.Packet.spore:type_name -> packets.SporeMessage
-	9,  // 11: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
-	10, // 12: packets.Packet.spores_batch:type_name -> packets.SporesBatchMessage
-	11, // 13: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
-	12, // 14: packets.Packet.hiscore_board_request:type_name -> packets.HiscoreBoardRequestMessage
-	13, // 15: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
-	14, // 16: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
-	15, // 17: packets.Packet.finished_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
-	16, // 18: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
-	17, // 19: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	10, // 0: packets.SporesBatchMessage.spores:type_name -> packets.SporeMessage
+	15, // 1: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
+	21, // 2: packets.ArenaListMessage.arenas:type_name -> packets.ArenaMessage
+	0,  // 3: packets.CreateArenaRequestMessage.world_shape:type_name -> packets.WorldShape
+	41, // 4: packets.RoundOverMessage.standings:type_name -> packets.StandingMessage
+	44, // 5: packets.TeamScoresMessage.teams:type_name -> packets.TeamScoreMessage
+	46, // 6: packets.LeaderboardMessage.entries:type_name -> packets.LeaderboardEntryMessage
+	1,  // 7: packets.ZoneMessage.state:type_name -> packets.ZoneState
+	0,  // 8: packets.WorldMessage.shape:type_name -> packets.WorldShape
+	53, // 9: packets.WorldMessage.obstacles:type_name -> packets.RegionMessage
+	53, // 10: packets.WorldMessage.spawn_zones:type_name -> packets.RegionMessage
+	53, // 11: packets.WorldMessage.spore_regions:type_name -> packets.RegionMessage
+	53, // 12: packets.WorldMessage.control_zones:type_name -> packets.RegionMessage
+	2,  // 13: packets.Packet.chat:type_name -> packets.ChatMessage
+	3,  // 14: packets.Packet.id:type_name -> packets.IdMessage
+	4,  // 15: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
+	5,  // 16: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
+	6,  // 17: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
+	7,  // 18: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
+	8,  // 19: packets.Packet.player:type_name -> packets.PlayerMessage
+	9,  // 20: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
+	10, // 21: packets.Packet.spore:type_name -> packets.SporeMessage
+	11, // 22: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
+	12, // 23: packets.Packet.spores_batch:type_name -> packets.SporesBatchMessage
+	13, // 24: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
+	14, // 25: packets.Packet.hiscore_board_request:type_name -> packets.HiscoreBoardRequestMessage
+	15, // 26: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
+	16, // 27: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
+	17, // 28: packets.Packet.finished_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
+	18, // 29: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
+	19, // 30: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
+	20, // 31: packets.Packet.arena_list_request:type_name -> packets.ArenaListRequestMessage
+	22, // 32: packets.Packet.arena_list:type_name -> packets.ArenaListMessage
+	23, // 33: packets.Packet.create_arena_request:type_name -> packets.CreateArenaRequestMessage
+	24, // 34: packets.Packet.arena_created:type_name -> packets.ArenaCreatedMessage
+	25, // 35: packets.Packet.kick_player:type_name -> packets.KickPlayerMessage
+	26, // 36: packets.Packet.spectate_request:type_name -> packets.SpectateRequestMessage
+	27, // 37: packets.Packet.spectating:type_name -> packets.SpectatingMessage
+	28, // 38: packets.Packet.finished_spectating:type_name -> packets.FinishedSpectatingMessage
+	29, // 39: packets.Packet.death_summary:type_name -> packets.DeathSummaryMessage
+	30, // 40: packets.Packet.respawn_request:type_name -> packets.RespawnRequestMessage
+	31, // 41: packets.Packet.split:type_name -> packets.SplitMessage
+	32, // 42: packets.Packet.cell_removed:type_name -> packets.CellRemovedMessage
+	33, // 43: packets.Packet.eject_mass:type_name -> packets.EjectMassMessage
+	34, // 44: packets.Packet.hazard:type_name -> packets.HazardMessage
+	35, // 45: packets.Packet.hazard_consumed:type_name -> packets.HazardConsumedMessage
+	36, // 46: packets.Packet.boost:type_name -> packets.BoostMessage
+	54, // 47: packets.Packet.world:type_name -> packets.WorldMessage
+	37, // 48: packets.Packet.power_up:type_name -> packets.PowerUpMessage
+	38, // 49: packets.Packet.power_up_collected:type_name -> packets.PowerUpCollectedMessage
+	39, // 50: packets.Packet.game_mode:type_name -> packets.GameModeMessage
+	40, // 51: packets.Packet.safe_zone:type_name -> packets.SafeZoneMessage
+	42, // 52: packets.Packet.round_over:type_name -> packets.RoundOverMessage
+	43, // 53: packets.Packet.mass_share:type_name -> packets.MassShareMessage
+	45, // 54: packets.Packet.team_scores:type_name -> packets.TeamScoresMessage
+	52, // 55: packets.Packet.zone:type_name -> packets.ZoneMessage
+	47, // 56: packets.Packet.leaderboard:type_name -> packets.LeaderboardMessage
+	48, // 57: packets.Packet.kill_feed:type_name -> packets.KillFeedMessage
+	49, // 58: packets.Packet.announcement:type_name -> packets.AnnouncementMessage
+	50, // 59: packets.Packet.combo:type_name -> packets.ComboMessage
+	51, // 60: packets.Packet.spores_expired:type_name -> packets.SporesExpiredMessage
+	61, // [61:61] is the sub-list for method output_type
+	61, // [61:61] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_packets_proto_init() }
@@ -1457,7 +4671,10 @@ func file_packets_proto_init() {
 	if File_packets_proto != nil {
 		return
 	}
-	file_packets_proto_msgTypes[18].OneofWrappers = []any{
+	file_packets_proto_msgTypes[7].OneofWrappers = []any{
+		(*PlayerDirectionMessage_Intensity)(nil),
+	}
+	file_packets_proto_msgTypes[53].OneofWrappers = []any{
 		(*Packet_Chat)(nil),
 		(*Packet_Id)(nil),
 		(*Packet_LoginRequest)(nil),
@@ -1476,19 +4693,50 @@ func file_packets_proto_init() {
 		(*Packet_FinishedBrowsingHiscores)(nil),
 		(*Packet_SearchHiscore)(nil),
 		(*Packet_Disconnect)(nil),
+		(*Packet_ArenaListRequest)(nil),
+		(*Packet_ArenaList)(nil),
+		(*Packet_CreateArenaRequest)(nil),
+		(*Packet_ArenaCreated)(nil),
+		(*Packet_KickPlayer)(nil),
+		(*Packet_SpectateRequest)(nil),
+		(*Packet_Spectating)(nil),
+		(*Packet_FinishedSpectating)(nil),
+		(*Packet_DeathSummary)(nil),
+		(*Packet_RespawnRequest)(nil),
+		(*Packet_Split)(nil),
+		(*Packet_CellRemoved)(nil),
+		(*Packet_EjectMass)(nil),
+		(*Packet_Hazard)(nil),
+		(*Packet_HazardConsumed)(nil),
+		(*Packet_Boost)(nil),
+		(*Packet_World)(nil),
+		(*Packet_PowerUp)(nil),
+		(*Packet_PowerUpCollected)(nil),
+		(*Packet_GameMode)(nil),
+		(*Packet_SafeZone)(nil),
+		(*Packet_RoundOver)(nil),
+		(*Packet_MassShare)(nil),
+		(*Packet_TeamScores)(nil),
+		(*Packet_Zone)(nil),
+		(*Packet_Leaderboard)(nil),
+		(*Packet_KillFeed)(nil),
+		(*Packet_Announcement)(nil),
+		(*Packet_Combo)(nil),
+		(*Packet_SporesExpired)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_packets_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      2,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_packets_proto_goTypes,
 		DependencyIndexes: file_packets_proto_depIdxs,
+		EnumInfos:         file_packets_proto_enumTypes,
 		MessageInfos:      file_packets_proto_msgTypes,
 	}.Build()
 	File_packets_proto = out.File
@@ -1,6 +1,10 @@
 package packets
 
-import "server/internal/server/objects"
+import (
+	"math"
+	"server/internal/server/objects"
+	"time"
+)
 
 type Msg = isPacket_Msg
 
@@ -35,16 +39,57 @@ func NewDenyResponse(reason string) Msg {
 }
 
 func NewPlayer(id uint64, player *objects.Player) Msg {
+	return NewPlayerCell(id, 0, player, &player.Cell)
+}
+
+// A message for one of the player's cells, where cell 0 is the player's main body
+func NewPlayerCell(id uint64, cellId uint64, player *objects.Player, cell *objects.Cell) Msg {
+	activeEffects := player.Effects()
+	effects := make([]string, 0, len(activeEffects))
+	for _, effect := range activeEffects {
+		if time.Now().Before(effect.ExpiresAt) {
+			effects = append(effects, effect.Kind)
+		}
+	}
+
 	return &Packet_Player{
 		Player: &PlayerMessage{
-			Id:        id,
-			Name:      player.Name,
-			X:         player.X,
-			Y:         player.Y,
-			Radius:    player.Radius,
-			Direction: player.Direction,
-			Speed:     player.Speed,
-			Color:     player.Color,
+			Id:         id,
+			Name:       player.Name,
+			X:          cell.X,
+			Y:          cell.Y,
+			Radius:     cell.Radius,
+			Direction:  player.Direction,
+			Speed:      player.Speed,
+			Color:      player.Color,
+			CellId:     cellId,
+			Effects:    effects,
+			Protected:  player.IsProtected(),
+			Team:       player.Team,
+			Role:       player.Role(),
+			ZonePoints: uint64(player.ZonePoints),
+			Bounty:     uint64(player.Bounty()),
+			VelX:       cell.VelX + cell.LaunchX,
+			VelY:       cell.VelY + cell.LaunchY,
+		},
+	}
+}
+
+func NewCellRemoved(playerId uint64, cellId uint64) Msg {
+	return &Packet_CellRemoved{
+		CellRemoved: &CellRemovedMessage{
+			PlayerId: playerId,
+			CellId:   cellId,
+		},
+	}
+}
+
+// NewPlayerDirection steers towards the given direction, as hard as the intensity from 0 to 1 says
+func NewPlayerDirection(direction float64, intensity float64) Msg {
+	return &Packet_PlayerDirection{
+		PlayerDirection: &PlayerDirectionMessage{
+			Direction: direction,
+			Throttle:  &PlayerDirectionMessage_Intensity{Intensity: intensity},
 		},
 	}
 }
@@ -55,6 +100,7 @@ func newSporeMessage(spore_id uint64, spore *objects.Spore) *SporeMessage {
 		X:      spore.X,
 		Y:      spore.Y,
 		Radius: spore.Radius,
+		Kind:   spore.Kind,
 	}
 }
 
@@ -77,10 +123,199 @@ func NewSporesBatch(spores map[uint64]*objects.Spore) Msg {
 	}
 }
 
-func NewHiscoreBoard(hiscores []*HiscoreMessage) Msg {
+func newRegionMessages(regions []objects.Region) []*RegionMessage {
+	regionMessages := make([]*RegionMessage, 0, len(regions))
+	for _, region := range regions {
+		regionMessages = append(regionMessages, &RegionMessage{
+			X:      region.X,
+			Y:      region.Y,
+			Width:  region.Width,
+			Height: region.Height,
+			Weight: region.Weight,
+		})
+	}
+	return regionMessages
+}
+
+// The world shapes in the packets line up with the ones in the objects package
+func NewWorld(world *objects.World, mapName string) Msg {
+	return &Packet_World{
+		World: &WorldMessage{
+			Shape:        WorldShape(world.Shape),
+			Size:         world.Size,
+			MapName:      mapName,
+			Obstacles:    newRegionMessages(world.Obstacles),
+			SpawnZones:   newRegionMessages(world.SpawnZones),
+			SporeRegions: newRegionMessages(world.SporeRegions),
+			ControlZones: newRegionMessages(world.ControlZones),
+		},
+	}
+}
+
+func NewSporeConsumed(sporeId uint64) Msg {
+	return &Packet_SporeConsumed{
+		SporeConsumed: &SporeConsumedMessage{
+			SporeId: sporeId,
+		},
+	}
+}
+
+func NewSporesExpired(sporeIds []uint64) Msg {
+	return &Packet_SporesExpired{
+		SporesExpired: &SporesExpiredMessage{
+			SporeIds: sporeIds,
+		},
+	}
+}
+
+func NewHazard(id uint64, hazard *objects.Hazard) Msg {
+	return &Packet_Hazard{
+		Hazard: &HazardMessage{
+			Id:     id,
+			X:      hazard.X,
+			Y:      hazard.Y,
+			Radius: hazard.Radius,
+		},
+	}
+}
+
+func NewHazardConsumed(hazardId uint64, cellId uint64) Msg {
+	return &Packet_HazardConsumed{
+		HazardConsumed: &HazardConsumedMessage{
+			HazardId: hazardId,
+			CellId:   cellId,
+		},
+	}
+}
+
+func NewPowerUp(id uint64, powerUp *objects.PowerUp) Msg {
+	return &Packet_PowerUp{
+		PowerUp: &PowerUpMessage{
+			Id:     id,
+			X:      powerUp.X,
+			Y:      powerUp.Y,
+			Radius: powerUp.Radius,
+			Kind:   powerUp.Kind,
+		},
+	}
+}
+
+func NewPowerUpCollected(powerUpId uint64, kind string, duration time.Duration) Msg {
+	return &Packet_PowerUpCollected{
+		PowerUpCollected: &PowerUpCollectedMessage{
+			PowerUpId:  powerUpId,
+			Kind:       kind,
+			DurationMs: uint64(duration.Milliseconds()),
+		},
+	}
+}
+
+// A message describing the arena's game mode, where lobby means the round left is the countdown to the next one
+func NewGameMode(name string, roundLeft time.Duration, lobby bool) Msg {
+	return &Packet_GameMode{
+		GameMode: &GameModeMessage{
+			Name:        name,
+			RoundMsLeft: uint64(max(roundLeft, 0).Milliseconds()),
+			Lobby:       lobby,
+		},
+	}
+}
+
+func NewSafeZone(x, y, radius float64) Msg {
+	return &Packet_SafeZone{
+		SafeZone: &SafeZoneMessage{
+			X:      x,
+			Y:      y,
+			Radius: radius,
+		},
+	}
+}
+
+func NewRoundOver(winner string, standings []*StandingMessage) Msg {
+	return &Packet_RoundOver{
+		RoundOver: &RoundOverMessage{
+			Winner:    winner,
+			Standings: standings,
+		},
+	}
+}
+
+func NewMassShare(playerId uint64, mass float64) Msg {
+	return &Packet_MassShare{
+		MassShare: &MassShareMessage{
+			PlayerId: playerId,
+			Mass:     mass,
+		},
+	}
+}
+
+func NewTeamScores(teams []*TeamScoreMessage) Msg {
+	return &Packet_TeamScores{
+		TeamScores: &TeamScoresMessage{
+			Teams: teams,
+		},
+	}
+}
+
+func NewZone(zoneId uint32, state ZoneState, holderId uint64, holderName string) Msg {
+	return &Packet_Zone{
+		Zone: &ZoneMessage{
+			ZoneId:     zoneId,
+			State:      state,
+			HolderId:   holderId,
+			HolderName: holderName,
+		},
+	}
+}
+
+// A leaderboard of the top players as seen by one player, whose own rank is 0 if they aren't playing
+func NewLeaderboard(entries []*LeaderboardEntryMessage, ownRank uint64, ownMass uint64, players uint64) Msg {
+	return &Packet_Leaderboard{
+		Leaderboard: &LeaderboardMessage{
+			Entries: entries,
+			OwnRank: ownRank,
+			OwnMass: ownMass,
+			Players: players,
+		},
+	}
+}
+
+func NewKillFeed(killerId uint64, killerName string, victimId uint64, victimName string, mass float64) Msg {
+	return &Packet_KillFeed{
+		KillFeed: &KillFeedMessage{
+			KillerId:   killerId,
+			KillerName: killerName,
+			VictimId:   victimId,
+			VictimName: victimName,
+			Mass:       mass,
+		},
+	}
+}
+
+func NewAnnouncement(kind string, playerId uint64, text string) Msg {
+	return &Packet_Announcement{
+		Announcement: &AnnouncementMessage{
+			Kind:     kind,
+			PlayerId: playerId,
+			Text:     text,
+		},
+	}
+}
+
+func NewCombo(combo int, multiplier float64) Msg {
+	return &Packet_Combo{
+		Combo: &ComboMessage{
+			Combo:      uint32(combo),
+			Multiplier: multiplier,
+		},
+	}
+}
+
+func NewHiscoreBoard(hiscores []*HiscoreMessage, category string) Msg {
 	return &Packet_HiscoreBoard{
 		HiscoreBoard: &HiscoreBoardMessage{
 			Hiscores: hiscores,
+			Category: category,
 		},
 	}
 }
@@ -92,3 +327,49 @@ func NewDisconnect(reason string) Msg {
 		},
 	}
 }
+
+func NewArenaList(arenas []*ArenaMessage) Msg {
+	return &Packet_ArenaList{
+		ArenaList: &ArenaListMessage{
+			Arenas: arenas,
+		},
+	}
+}
+
+func NewArenaCreated(arenaId uint64, inviteCode string) Msg {
+	return &Packet_ArenaCreated{
+		ArenaCreated: &ArenaCreatedMessage{
+			ArenaId:    arenaId,
+			InviteCode: inviteCode,
+		},
+	}
+}
+
+func NewKickPlayer(playerId uint64) Msg {
+	return &Packet_KickPlayer{
+		KickPlayer: &KickPlayerMessage{
+			PlayerId: playerId,
+		},
+	}
+}
+
+func NewSpectating(arenaId uint64, playerId uint64) Msg {
+	return &Packet_Spectating{
+		Spectating: &SpectatingMessage{
+			ArenaId:  arenaId,
+			PlayerId: playerId,
+		},
+	}
+}
+
+func NewDeathSummary(killerId uint64, killerName string, victim *objects.Player, diedAt time.Time) Msg {
+	return &Packet_DeathSummary{
+		DeathSummary: &DeathSummaryMessage{
+			KillerId:    killerId,
+			KillerName:  killerName,
+			PeakMass:    uint64(math.Round(victim.PeakMass)),
+			TimeAliveMs: uint64(diedAt.Sub(victim.SpawnedAt).Milliseconds()),
+			SporesEaten: uint64(victim.SporesEaten),
+		},
+	}
+}